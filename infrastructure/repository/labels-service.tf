@@ -152,6 +152,7 @@ variable "service_labels" {
     "neptune",
     "networkfirewall",
     "networkmanager",
+    "opensearch",
     "opsworks",
     "opsworkscm",
     "organizations",
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"math/rand"
 	"os"
 	"regexp"
 	"strconv"
@@ -99,6 +100,13 @@ var Provider *schema.Provider
 var testAccProviderConfigure sync.Once
 
 func init() {
+	// Random resource names are sent in requests, so they must be the same when
+	// recording and replaying HTTP interactions. Names generated before a test calls
+	// t.Parallel() are then deterministic for a given -run pattern.
+	if conns.RecordMode() != "" {
+		rand.Seed(1)
+	}
+
 	Provider = provider.Provider()
 
 	Providers = map[string]*schema.Provider{
@@ -193,10 +201,13 @@ func PreCheck(t *testing.T) {
 	// Since we are outside the scope of the Terraform configuration we must
 	// call Configure() to properly initialize the provider configuration.
 	testAccProviderConfigure.Do(func() {
-		conns.FailIfAllEnvVarEmpty(t, []string{conns.EnvVarProfile, conns.EnvVarAccessKeyId, conns.EnvVarContainerCredentialsFullUri}, "credentials for running acceptance testing")
+		// Replayed HTTP interactions do not require credentials.
+		if conns.RecordMode() != conns.RecordModeReplay {
+			conns.FailIfAllEnvVarEmpty(t, []string{conns.EnvVarProfile, conns.EnvVarAccessKeyId, conns.EnvVarContainerCredentialsFullUri}, "credentials for running acceptance testing")
 
-		if os.Getenv(conns.EnvVarAccessKeyId) != "" {
-			conns.FailIfEnvVarEmpty(t, conns.EnvVarSecretAccessKey, "static credentials value when using "+conns.EnvVarAccessKeyId)
+			if os.Getenv(conns.EnvVarAccessKeyId) != "" {
+				conns.FailIfEnvVarEmpty(t, conns.EnvVarSecretAccessKey, "static credentials value when using "+conns.EnvVarAccessKeyId)
+			}
 		}
 
 		// Setting the AWS_DEFAULT_REGION environment variable here allows all tests to omit
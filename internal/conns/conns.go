@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"strings"
 
+	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/route53domains"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
@@ -198,6 +200,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/networkfirewall"
 	"github.com/aws/aws-sdk-go/service/networkmanager"
 	"github.com/aws/aws-sdk-go/service/nimblestudio"
+	"github.com/aws/aws-sdk-go/service/opensearchservice"
 	"github.com/aws/aws-sdk-go/service/opsworks"
 	"github.com/aws/aws-sdk-go/service/opsworkscm"
 	"github.com/aws/aws-sdk-go/service/organizations"
@@ -286,6 +289,7 @@ import (
 	awsbasev1 "github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/version"
 )
@@ -482,6 +486,7 @@ const (
 	NetworkFirewall               = "networkfirewall"
 	NetworkManager                = "networkmanager"
 	NimbleStudio                  = "nimblestudio"
+	OpenSearch                    = "opensearch"
 	OpsWorks                      = "opsworks"
 	OpsWorksCM                    = "opsworkscm"
 	Organizations                 = "organizations"
@@ -778,6 +783,7 @@ func init() {
 	serviceData[NetworkFirewall] = &ServiceDatum{AWSClientName: "NetworkFirewall", AWSServiceName: networkfirewall.ServiceName, AWSEndpointsID: networkfirewall.EndpointsID, AWSServiceID: networkfirewall.ServiceID, ProviderNameUpper: "NetworkFirewall", HCLKeys: []string{"networkfirewall"}}
 	serviceData[NetworkManager] = &ServiceDatum{AWSClientName: "NetworkManager", AWSServiceName: networkmanager.ServiceName, AWSEndpointsID: networkmanager.EndpointsID, AWSServiceID: networkmanager.ServiceID, ProviderNameUpper: "NetworkManager", HCLKeys: []string{"networkmanager"}}
 	serviceData[NimbleStudio] = &ServiceDatum{AWSClientName: "NimbleStudio", AWSServiceName: nimblestudio.ServiceName, AWSEndpointsID: nimblestudio.EndpointsID, AWSServiceID: nimblestudio.ServiceID, ProviderNameUpper: "NimbleStudio", HCLKeys: []string{"nimblestudio"}}
+	serviceData[OpenSearch] = &ServiceDatum{AWSClientName: "OpenSearchService", AWSServiceName: opensearchservice.ServiceName, AWSEndpointsID: opensearchservice.EndpointsID, AWSServiceID: opensearchservice.ServiceID, ProviderNameUpper: "OpenSearch", HCLKeys: []string{"opensearch", "opensearchservice"}}
 	serviceData[OpsWorks] = &ServiceDatum{AWSClientName: "OpsWorks", AWSServiceName: opsworks.ServiceName, AWSEndpointsID: opsworks.EndpointsID, AWSServiceID: opsworks.ServiceID, ProviderNameUpper: "OpsWorks", HCLKeys: []string{"opsworks"}}
 	serviceData[OpsWorksCM] = &ServiceDatum{AWSClientName: "OpsWorksCM", AWSServiceName: opsworkscm.ServiceName, AWSEndpointsID: opsworkscm.EndpointsID, AWSServiceID: opsworkscm.ServiceID, ProviderNameUpper: "OpsWorksCM", HCLKeys: []string{"opsworkscm"}}
 	serviceData[Organizations] = &ServiceDatum{AWSClientName: "Organizations", AWSServiceName: organizations.ServiceName, AWSEndpointsID: organizations.EndpointsID, AWSServiceID: organizations.ServiceID, ProviderNameUpper: "Organizations", HCLKeys: []string{"organizations"}}
@@ -868,6 +874,8 @@ type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
 	AssumeRole                     *awsbase.AssumeRole
+	AssumeRoleWithWebIdentity      *AssumeRoleWithWebIdentity
+	ConcurrencyLimits              map[string]int
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
 	EC2MetadataServiceEndpoint     string
@@ -880,6 +888,8 @@ type Config struct {
 	MaxRetries                     int
 	Profile                        string
 	Region                         string
+	RequiredTagsConfig             *tftags.RequiredConfig
+	RetryMode                      string
 	S3UsePathStyle                 bool
 	SecretKey                      string
 	SharedConfigFiles              []string
@@ -957,6 +967,7 @@ type AWSClient struct {
 	CognitoSyncConn                   *cognitosync.CognitoSync
 	ComprehendConn                    *comprehend.Comprehend
 	ComprehendMedicalConn             *comprehendmedical.ComprehendMedical
+	ConcurrencySemaphores             map[string]tfsync.Semaphore
 	ConfigServiceConn                 *configservice.ConfigService
 	ConnectConn                       *connect.Connect
 	ConnectContactLensConn            *connectcontactlens.ConnectContactLens
@@ -1090,6 +1101,7 @@ type AWSClient struct {
 	NetworkFirewallConn               *networkfirewall.NetworkFirewall
 	NetworkManagerConn                *networkmanager.NetworkManager
 	NimbleStudioConn                  *nimblestudio.NimbleStudio
+	OpenSearchConn                    *opensearchservice.OpenSearchService
 	OpsWorksCMConn                    *opsworkscm.OpsWorksCM
 	OpsWorksConn                      *opsworks.OpsWorks
 	OrganizationsConn                 *organizations.Organizations
@@ -1115,6 +1127,7 @@ type AWSClient struct {
 	RedshiftDataConn                  *redshiftdataapiservice.RedshiftDataAPIService
 	Region                            string
 	RekognitionConn                   *rekognition.Rekognition
+	RequiredTagsConfig                *tftags.RequiredConfig
 	ResourceGroupsConn                *resourcegroups.ResourceGroups
 	ResourceGroupsTaggingAPIConn      *resourcegroupstaggingapi.ResourceGroupsTaggingAPI
 	ReverseDNSPrefix                  string
@@ -1141,6 +1154,7 @@ type AWSClient struct {
 	ServiceDiscoveryConn              *servicediscovery.ServiceDiscovery
 	ServiceQuotasConn                 *servicequotas.ServiceQuotas
 	SESConn                           *ses.SES
+	Session                           *session.Session
 	SESV2Conn                         *sesv2.SESV2
 	SFNConn                           *sfn.SFN
 	ShieldConn                        *shield.Shield
@@ -1182,6 +1196,13 @@ type AWSClient struct {
 	XRayConn                          *xray.XRay
 }
 
+// ConcurrencySemaphore returns the semaphore limiting concurrent resource operations for the specified service, if any
+func (client *AWSClient) ConcurrencySemaphore(service string) (tfsync.Semaphore, bool) {
+	semaphore, ok := client.ConcurrencySemaphores[service]
+
+	return semaphore, ok
+}
+
 // PartitionHostname returns a hostname with the provider domain suffix for the partition
 // e.g. PREFIX.amazonaws.com
 // The prefix should not contain a trailing period.
@@ -1219,7 +1240,35 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 		UseFIPSEndpoint:         c.UseFIPSEndpoint,
 	}
 
-	if c.AssumeRole != nil && c.AssumeRole.RoleARN != "" {
+	var rec *recorder
+	if mode := RecordMode(); mode != "" {
+		var err error
+		rec, err = newRecorder(mode)
+		if err != nil {
+			return nil, diag.Errorf("error configuring Terraform AWS Provider: %s", err)
+		}
+	}
+	replay := rec != nil && rec.mode == RecordModeReplay
+
+	var webIdentityProvider *webIdentityCredentialsProvider
+	if c.AssumeRoleWithWebIdentity != nil && c.AssumeRoleWithWebIdentity.RoleARN != "" && !replay {
+		var err error
+		webIdentityProvider, err = newWebIdentityCredentialsProvider(ctx, c)
+		if err != nil {
+			return nil, diag.Errorf("error configuring Terraform AWS Provider: %s", err)
+		}
+
+		creds, err := webIdentityProvider.Retrieve(ctx)
+		if err != nil {
+			return nil, diag.Errorf("error configuring Terraform AWS Provider: %s", err)
+		}
+
+		// The initial web identity credentials are only used to load the configuration.
+		// They are replaced below by providers that refresh them on expiry.
+		awsbaseConfig.AccessKey = creds.AccessKeyID
+		awsbaseConfig.SecretKey = creds.SecretAccessKey
+		awsbaseConfig.Token = creds.SessionToken
+	} else if c.AssumeRole != nil && c.AssumeRole.RoleARN != "" && !replay {
 		awsbaseConfig.AssumeRole = c.AssumeRole
 	}
 
@@ -1244,11 +1293,39 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 		awsbaseConfig.StsRegion = c.STSRegion
 	}
 
+	// Replayed interactions must not depend on, or send requests to resolve, real credentials.
+	if replay {
+		awsbaseConfig.AccessKey = recordAccessKeyID
+		awsbaseConfig.SecretKey = recordSecretAccessKey
+		awsbaseConfig.Token = ""
+		awsbaseConfig.Profile = ""
+		awsbaseConfig.SkipEC2MetadataApiCheck = true
+	}
+
 	cfg, err := awsbase.GetAwsConfig(ctx, &awsbaseConfig)
 	if err != nil {
 		return nil, diag.Errorf("error configuring Terraform AWS Provider: %s", err)
 	}
 
+	if rec != nil {
+		cfg.HTTPClient = &http.Client{Transport: rec.wrap(roundTripperFunc(cfg.HTTPClient.Do))}
+	}
+
+	// Refresh the web identity credentials on expiry instead of using the static credentials
+	// from the initial exchange, including when they are the source credentials for assume_role.
+	if webIdentityProvider != nil {
+		cfg.Credentials = awsv2.NewCredentialsCache(webIdentityProvider)
+
+		if c.AssumeRole != nil && c.AssumeRole.RoleARN != "" {
+			provider, err := newChainedAssumeRoleCredentialsProvider(ctx, cfg, c, cfg.Credentials)
+			if err != nil {
+				return nil, diag.Errorf("error configuring Terraform AWS Provider: %s", err)
+			}
+
+			cfg.Credentials = provider
+		}
+	}
+
 	if !c.SkipRegionValidation {
 		if err := awsbase.ValidateRegion(cfg.Region); err != nil {
 			return nil, diag.FromErr(err)
@@ -1261,11 +1338,25 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 		return nil, diag.Errorf("error creating AWS SDK v1 session: %s", err)
 	}
 
+	if rec != nil {
+		transport := sess.Config.HTTPClient.Transport
+		if transport == nil {
+			transport = http.DefaultTransport
+		}
+		sess.Config.HTTPClient.Transport = rec.wrap(transport)
+	}
+
+	registerRetryModeHandlers(sess, c.RetryMode)
+
 	accountID, Partition, err := awsbase.GetAwsAccountIDAndPartition(ctx, cfg, &awsbaseConfig)
 	if err != nil {
 		return nil, diag.Errorf("error retrieving account details: %s", err)
 	}
 
+	if rec != nil {
+		rec.setAccountID(accountID)
+	}
+
 	if accountID == "" {
 		log.Println("[WARN] AWS account ID not found for provider. See https://www.terraform.io/docs/providers/aws/index.html#skip_requesting_account_id for implications.")
 	}
@@ -1487,6 +1578,7 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 		NetworkFirewallConn:               networkfirewall.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[NetworkFirewall])})),
 		NetworkManagerConn:                networkmanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[NetworkManager])})),
 		NimbleStudioConn:                  nimblestudio.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[NimbleStudio])})),
+		OpenSearchConn:                    opensearchservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[OpenSearch])})),
 		OpsWorksCMConn:                    opsworkscm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[OpsWorksCM])})),
 		OpsWorksConn:                      opsworks.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[OpsWorks])})),
 		OrganizationsConn:                 organizations.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Organizations])})),
@@ -1512,6 +1604,7 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 		RedshiftDataConn:                  redshiftdataapiservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[RedshiftData])})),
 		Region:                            c.Region,
 		RekognitionConn:                   rekognition.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Rekognition])})),
+		RequiredTagsConfig:                c.RequiredTagsConfig,
 		ResourceGroupsConn:                resourcegroups.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ResourceGroups])})),
 		ResourceGroupsTaggingAPIConn:      resourcegroupstaggingapi.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ResourceGroupsTaggingAPI])})),
 		ReverseDNSPrefix:                  ReverseDNS(DNSSuffix),
@@ -1539,6 +1632,7 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 		ServiceDiscoveryConn:             servicediscovery.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ServiceDiscovery])})),
 		ServiceQuotasConn:                servicequotas.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ServiceQuotas])})),
 		SESConn:                          ses.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SES])})),
+		Session:                          sess,
 		SESV2Conn:                        sesv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SESV2])})),
 		SFNConn:                          sfn.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SFN])})),
 		SignerConn:                       signer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Signer])})),
@@ -1577,6 +1671,15 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 		XRayConn:                         xray.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[XRay])})),
 	}
 
+	if len(c.ConcurrencyLimits) > 0 {
+		client.ConcurrencySemaphores = make(map[string]tfsync.Semaphore, len(c.ConcurrencyLimits))
+
+		for service, limit := range c.ConcurrencyLimits {
+			log.Printf("[INFO] Limiting concurrent %s resource operations to %d", service, limit)
+			client.ConcurrencySemaphores[service] = make(tfsync.Semaphore, limit)
+		}
+	}
+
 	// sts
 	stsConfig := &aws.Config{
 		Endpoint: aws.String(c.Endpoints[STS]),
@@ -0,0 +1,23 @@
+package conns
+
+import (
+	"context"
+)
+
+type contextKey int
+
+const (
+	resourceTypeContextKey contextKey = iota
+)
+
+// NewResourceTypeContext returns a context carrying the type name, e.g. "aws_s3_bucket", of the resource being operated on.
+func NewResourceTypeContext(ctx context.Context, resourceType string) context.Context {
+	return context.WithValue(ctx, resourceTypeContextKey, resourceType)
+}
+
+// ResourceTypeFromContext returns the resource type name carried by a context, if any.
+func ResourceTypeFromContext(ctx context.Context) (string, bool) {
+	resourceType, ok := ctx.Value(resourceTypeContextKey).(string)
+
+	return resourceType, ok && resourceType != ""
+}
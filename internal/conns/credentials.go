@@ -0,0 +1,205 @@
+package conns
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	"github.com/mitchellh/go-homedir"
+)
+
+const (
+	webIdentityCredentialsProviderName = "WebIdentityCredentials"
+)
+
+// AssumeRoleWithWebIdentity holds the configuration for exchanging an OpenID Connect (OIDC)
+// web identity token for temporary credentials via STS AssumeRoleWithWebIdentity.
+type AssumeRoleWithWebIdentity struct {
+	Duration             time.Duration
+	Policy               string
+	PolicyARNs           []string
+	RoleARN              string
+	SessionName          string
+	WebIdentityToken     string
+	WebIdentityTokenFile string
+}
+
+// webIdentityToken returns the web identity token, reading it from file when configured.
+// The file is re-read on every call as token issuers commonly rotate the token in place.
+func (ar *AssumeRoleWithWebIdentity) webIdentityToken() (string, error) {
+	if ar.WebIdentityToken != "" {
+		return ar.WebIdentityToken, nil
+	}
+
+	if ar.WebIdentityTokenFile == "" {
+		return "", fmt.Errorf("one of web_identity_token or web_identity_token_file must be set")
+	}
+
+	filename, err := homedir.Expand(ar.WebIdentityTokenFile)
+	if err != nil {
+		return "", fmt.Errorf("expanding web identity token file (%s): %w", ar.WebIdentityTokenFile, err)
+	}
+
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return "", fmt.Errorf("reading web identity token file (%s): %w", filename, err)
+	}
+
+	return string(b), nil
+}
+
+// webIdentityCredentialsProvider is an AWS SDK for Go v2 credentials provider that calls
+// STS AssumeRoleWithWebIdentity each time credentials are retrieved.
+type webIdentityCredentialsProvider struct {
+	assumeRole *AssumeRoleWithWebIdentity
+	client     *sts.Client
+}
+
+// newWebIdentityCredentialsProvider returns a credentials provider for the specified configuration.
+// AssumeRoleWithWebIdentity does not require signing so no source credentials are configured.
+func newWebIdentityCredentialsProvider(ctx context.Context, c *Config) (*webIdentityCredentialsProvider, error) {
+	cfg, err := config.LoadDefaultConfig(ctx,
+		config.WithCredentialsProvider(awsv2.AnonymousCredentials{}),
+		config.WithRegion(c.Region),
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("loading configuration: %w", err)
+	}
+
+	client := sts.NewFromConfig(cfg, func(opts *sts.Options) {
+		if c.STSRegion != "" {
+			opts.Region = c.STSRegion
+		}
+		if v := c.Endpoints[STS]; v != "" {
+			log.Printf("[INFO] STS client: setting custom endpoint: %s", v)
+			opts.EndpointResolver = sts.EndpointResolverFromURL(v)
+		}
+	})
+
+	return &webIdentityCredentialsProvider{
+		assumeRole: c.AssumeRoleWithWebIdentity,
+		client:     client,
+	}, nil
+}
+
+func (p *webIdentityCredentialsProvider) Retrieve(ctx context.Context) (awsv2.Credentials, error) {
+	ar := p.assumeRole
+
+	token, err := ar.webIdentityToken()
+	if err != nil {
+		return awsv2.Credentials{}, err
+	}
+
+	sessionName := ar.SessionName
+	if sessionName == "" {
+		sessionName = strconv.FormatInt(time.Now().UnixNano(), 10)
+	}
+
+	input := &sts.AssumeRoleWithWebIdentityInput{
+		RoleArn:          awsv2.String(ar.RoleARN),
+		RoleSessionName:  awsv2.String(sessionName),
+		WebIdentityToken: awsv2.String(token),
+	}
+
+	if ar.Duration != 0 {
+		input.DurationSeconds = awsv2.Int32(int32(ar.Duration / time.Second))
+	}
+
+	if ar.Policy != "" {
+		input.Policy = awsv2.String(ar.Policy)
+	}
+
+	for _, policyARN := range ar.PolicyARNs {
+		input.PolicyArns = append(input.PolicyArns, ststypes.PolicyDescriptorType{
+			Arn: awsv2.String(policyARN),
+		})
+	}
+
+	log.Printf("[INFO] Assuming IAM Role %q with web identity (SessionName: %q)", ar.RoleARN, sessionName)
+	output, err := p.client.AssumeRoleWithWebIdentity(ctx, input)
+
+	if err != nil {
+		return awsv2.Credentials{}, fmt.Errorf("error assuming IAM Role (%s) with web identity: %w", ar.RoleARN, err)
+	}
+
+	if output == nil || output.Credentials == nil {
+		return awsv2.Credentials{}, fmt.Errorf("error assuming IAM Role (%s) with web identity: empty result", ar.RoleARN)
+	}
+
+	credentials := awsv2.Credentials{
+		AccessKeyID:     awsv2.ToString(output.Credentials.AccessKeyId),
+		SecretAccessKey: awsv2.ToString(output.Credentials.SecretAccessKey),
+		SessionToken:    awsv2.ToString(output.Credentials.SessionToken),
+		Source:          webIdentityCredentialsProviderName,
+	}
+
+	if v := output.Credentials.Expiration; v != nil {
+		credentials.CanExpire = true
+		credentials.Expires = *v
+	}
+
+	return credentials, nil
+}
+
+// newChainedAssumeRoleCredentialsProvider returns a cached credentials provider that assumes the
+// configured assume_role role using the specified (web identity) credentials as source credentials.
+// Both the source and the assumed role credentials are refreshed on expiry.
+func newChainedAssumeRoleCredentialsProvider(ctx context.Context, cfg awsv2.Config, c *Config, source awsv2.CredentialsProvider) (awsv2.CredentialsProvider, error) {
+	ar := c.AssumeRole
+
+	cfg.Credentials = source
+	client := sts.NewFromConfig(cfg, func(opts *sts.Options) {
+		if c.STSRegion != "" {
+			opts.Region = c.STSRegion
+		}
+		if v := c.Endpoints[STS]; v != "" {
+			opts.EndpointResolver = sts.EndpointResolverFromURL(v)
+		}
+	})
+
+	provider := stscreds.NewAssumeRoleProvider(client, ar.RoleARN, func(opts *stscreds.AssumeRoleOptions) {
+		opts.RoleSessionName = ar.SessionName
+		opts.Duration = ar.Duration
+
+		if ar.ExternalID != "" {
+			opts.ExternalID = awsv2.String(ar.ExternalID)
+		}
+
+		if ar.Policy != "" {
+			opts.Policy = awsv2.String(ar.Policy)
+		}
+
+		for _, policyARN := range ar.PolicyARNs {
+			opts.PolicyARNs = append(opts.PolicyARNs, ststypes.PolicyDescriptorType{
+				Arn: awsv2.String(policyARN),
+			})
+		}
+
+		for k, v := range ar.Tags {
+			opts.Tags = append(opts.Tags, ststypes.Tag{
+				Key:   awsv2.String(k),
+				Value: awsv2.String(v),
+			})
+		}
+
+		opts.TransitiveTagKeys = ar.TransitiveTagKeys
+	})
+
+	log.Printf("[INFO] Assuming IAM Role %q with web identity source credentials (SessionName: %q, ExternalId: %q)", ar.RoleARN, ar.SessionName, ar.ExternalID)
+	cache := awsv2.NewCredentialsCache(provider)
+
+	if _, err := cache.Retrieve(ctx); err != nil {
+		return nil, fmt.Errorf("error assuming IAM Role (%s): %w", ar.RoleARN, err)
+	}
+
+	return cache, nil
+}
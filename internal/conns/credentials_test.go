@@ -0,0 +1,157 @@
+package conns
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/servicemocks"
+)
+
+func TestWebIdentityCredentialsProvider(t *testing.T) {
+	testCases := []struct {
+		Name                 string
+		WebIdentityToken     string
+		WebIdentityTokenFile bool
+		ExpectError          bool
+	}{
+		{
+			Name:             "token",
+			WebIdentityToken: servicemocks.MockWebIdentityToken,
+		},
+		{
+			Name:                 "token file",
+			WebIdentityTokenFile: true,
+		},
+		{
+			Name:             "invalid token",
+			WebIdentityToken: "InvalidWebIdentityToken",
+			ExpectError:      true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			oldEnv := servicemocks.InitSessionTestEnv()
+			defer servicemocks.PopEnv(oldEnv)
+
+			ts := servicemocks.MockAwsApiServer("STS", []*servicemocks.MockEndpoint{
+				servicemocks.MockStsAssumeRoleWithWebIdentityValidEndpoint,
+			})
+			defer ts.Close()
+
+			assumeRole := &AssumeRoleWithWebIdentity{
+				RoleARN:          servicemocks.MockStsAssumeRoleWithWebIdentityArn,
+				SessionName:      servicemocks.MockStsAssumeRoleWithWebIdentitySessionName,
+				WebIdentityToken: testCase.WebIdentityToken,
+			}
+
+			if testCase.WebIdentityTokenFile {
+				file, err := os.CreateTemp("", "aws-sdk-go-base-web-identity-token-file")
+				if err != nil {
+					t.Fatalf("unexpected error creating temporary web identity token file: %s", err)
+				}
+				defer os.Remove(file.Name())
+
+				if _, err := file.WriteString(servicemocks.MockWebIdentityToken); err != nil {
+					t.Fatalf("unexpected error writing web identity token file: %s", err)
+				}
+				file.Close()
+
+				assumeRole.WebIdentityTokenFile = file.Name()
+			}
+
+			config := &Config{
+				AssumeRoleWithWebIdentity: assumeRole,
+				Endpoints:                 map[string]string{STS: ts.URL},
+				Region:                    "us-east-1", //lintignore:AWSAT003
+			}
+
+			provider, err := newWebIdentityCredentialsProvider(context.Background(), config)
+			if err != nil {
+				t.Fatalf("unexpected error creating credentials provider: %s", err)
+			}
+
+			credentials, err := provider.Retrieve(context.Background())
+
+			if testCase.ExpectError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error retrieving credentials: %s", err)
+			}
+
+			if got, expected := credentials.AccessKeyID, servicemocks.MockStsAssumeRoleWithWebIdentityAccessKey; got != expected {
+				t.Errorf("got access key %s, expected %s", got, expected)
+			}
+
+			if got, expected := credentials.SecretAccessKey, servicemocks.MockStsAssumeRoleWithWebIdentitySecretKey; got != expected {
+				t.Errorf("got secret key %s, expected %s", got, expected)
+			}
+
+			if got, expected := credentials.SessionToken, servicemocks.MockStsAssumeRoleWithWebIdentitySessionToken; got != expected {
+				t.Errorf("got session token %s, expected %s", got, expected)
+			}
+
+			if !credentials.CanExpire {
+				t.Error("expected credentials to expire")
+			}
+		})
+	}
+}
+
+func TestChainedAssumeRoleCredentialsProvider(t *testing.T) {
+	oldEnv := servicemocks.InitSessionTestEnv()
+	defer servicemocks.PopEnv(oldEnv)
+
+	ts := servicemocks.MockAwsApiServer("STS", []*servicemocks.MockEndpoint{
+		servicemocks.MockStsAssumeRoleWithWebIdentityValidEndpoint,
+		servicemocks.MockStsAssumeRoleValidEndpoint,
+	})
+	defer ts.Close()
+
+	config := &Config{
+		AssumeRole: &awsbase.AssumeRole{
+			Duration:    15 * time.Minute,
+			RoleARN:     servicemocks.MockStsAssumeRoleArn,
+			SessionName: servicemocks.MockStsAssumeRoleSessionName,
+		},
+		AssumeRoleWithWebIdentity: &AssumeRoleWithWebIdentity{
+			RoleARN:          servicemocks.MockStsAssumeRoleWithWebIdentityArn,
+			SessionName:      servicemocks.MockStsAssumeRoleWithWebIdentitySessionName,
+			WebIdentityToken: servicemocks.MockWebIdentityToken,
+		},
+		Endpoints: map[string]string{STS: ts.URL},
+		Region:    "us-east-1", //lintignore:AWSAT003
+	}
+
+	webIdentityProvider, err := newWebIdentityCredentialsProvider(context.Background(), config)
+	if err != nil {
+		t.Fatalf("unexpected error creating web identity credentials provider: %s", err)
+	}
+
+	source := awsv2.NewCredentialsCache(webIdentityProvider)
+	cfg := awsv2.Config{Region: config.Region}
+
+	provider, err := newChainedAssumeRoleCredentialsProvider(context.Background(), cfg, config, source)
+	if err != nil {
+		t.Fatalf("unexpected error creating credentials provider: %s", err)
+	}
+
+	credentials, err := provider.Retrieve(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error retrieving credentials: %s", err)
+	}
+
+	if got, expected := credentials.AccessKeyID, servicemocks.MockStsAssumeRoleAccessKey; got != expected {
+		t.Errorf("got access key %s, expected %s", got, expected)
+	}
+}
@@ -56,6 +56,19 @@ const (
 	EnvVarAccAssumeRoleARN = "TF_ACC_ASSUME_ROLE_ARN"
 )
 
+// Custom environment variables used for recording and replaying HTTP interactions in acceptance testing
+const (
+	// Record mode, either "record" to save interactions with AWS to cassettes
+	// or "replay" to serve responses from cassettes without calling AWS
+	EnvVarRecordMode = "TF_AWS_RECORD_MODE"
+
+	// Directory containing cassettes. Defaults to test-fixtures/cassettes.
+	EnvVarRecordDir = "TF_AWS_RECORD_DIR"
+
+	// Cassette name. Defaults to the value of the test -run flag.
+	EnvVarRecordCassette = "TF_AWS_RECORD_CASSETTE"
+)
+
 // Custom environment variables used for assuming a role with resource sweepers
 const (
 	// The ARN of the IAM Role to assume
@@ -72,6 +85,24 @@ const (
 	EnvVarAssumeRoleSessionName = "TF_AWS_ASSUME_ROLE_SESSION_NAME"
 )
 
+// Custom environment variables used for running, selecting and reporting resources with resource sweepers
+const (
+	// Whether to only report the resources that would be deleted
+	EnvVarSweepDryRun = "TF_AWS_SWEEP_DRY_RUN"
+
+	// Only sweep resources whose identifier, name or Name tag begins with this prefix
+	EnvVarSweepNamePrefix = "TF_AWS_SWEEP_NAME_PREFIX"
+
+	// Only sweep resources with all of these comma-separated key=value tags
+	EnvVarSweepTags = "TF_AWS_SWEEP_TAGS"
+
+	// File to write the JSON sweeper report to
+	EnvVarSweepReport = "TF_AWS_SWEEP_REPORT"
+
+	// The number of sweepers to run concurrently in a region
+	EnvVarSweepConcurrency = "TF_AWS_SWEEP_CONCURRENCY"
+)
+
 // GetEnvVarWithDefault gets an environment variable value if non-empty or returns the default.
 func GetEnvVarWithDefault(variable string, defaultValue string) string {
 	value := os.Getenv(variable)
@@ -0,0 +1,122 @@
+package conns
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRecorder(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv(EnvVarRecordDir, dir)
+	t.Setenv(EnvVarRecordCassette, "TestRecorder")
+
+	path := filepath.Join(dir, "TestRecorder.json")
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/xml")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(testRecorderResponse)) //nolint:errcheck
+	}))
+	defer ts.Close()
+
+	// Record.
+	rec, err := newRecorder(RecordModeRecord)
+	if err != nil {
+		t.Fatalf("unexpected error creating recorder: %s", err)
+	}
+
+	client := &http.Client{Transport: rec.wrap(http.DefaultTransport)}
+
+	if _, err := testRecorderRequest(client, ts.URL); err != nil {
+		t.Fatalf("unexpected error recording request: %s", err)
+	}
+
+	rec.setAccountID("111122223333")
+
+	if _, err := testRecorderRequest(client, ts.URL+"/111122223333/"); err != nil {
+		t.Fatalf("unexpected error recording request: %s", err)
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("unexpected error reading cassette: %s", err)
+	}
+
+	for _, v := range []string{"111122223333", "ASIAEXAMPLE", "MockSecretKey", "MockSessionToken"} {
+		if strings.Contains(string(b), v) {
+			t.Errorf("cassette contains unscrubbed value %q", v)
+		}
+	}
+
+	// Replay.
+	delete(recorders, path)
+	ts.Close()
+
+	rec, err = newRecorder(RecordModeReplay)
+	if err != nil {
+		t.Fatalf("unexpected error creating recorder: %s", err)
+	}
+
+	client = &http.Client{Transport: rec.wrap(http.DefaultTransport)}
+
+	body, err := testRecorderRequest(client, ts.URL+"/"+recordAccountID+"/")
+	if err != nil {
+		t.Fatalf("unexpected error replaying request: %s", err)
+	}
+
+	if !strings.Contains(body, "<Account>"+recordAccountID+"</Account>") {
+		t.Errorf("expected replayed response to contain account ID %s, got: %s", recordAccountID, body)
+	}
+
+	if _, err := testRecorderRequest(client, ts.URL); err != nil {
+		t.Fatalf("unexpected error replaying request: %s", err)
+	}
+
+	_, err = testRecorderRequest(client, ts.URL)
+
+	var replayErr *replayError
+	if !errors.As(err, &replayErr) {
+		t.Fatalf("expected replay error, got: %v", err)
+	}
+}
+
+func testRecorderRequest(client *http.Client, url string) (string, error) {
+	req, err := http.NewRequest(http.MethodPost, url, strings.NewReader("Action=GetCallerIdentity&Version=2011-06-15"))
+	if err != nil {
+		return "", err
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+
+	return string(b), err
+}
+
+const testRecorderResponse = `<GetCallerIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <GetCallerIdentityResult>
+    <UserId>AKIAI44QH8DHBEXAMPLE</UserId>
+    <Account>111122223333</Account>
+    <Credentials>
+      <AccessKeyId>ASIAEXAMPLE</AccessKeyId>
+      <SecretAccessKey>MockSecretKey</SecretAccessKey>
+      <SessionToken>MockSessionToken</SessionToken>
+    </Credentials>
+  </GetCallerIdentityResult>
+  <ResponseMetadata>
+    <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+  </ResponseMetadata>
+</GetCallerIdentityResponse>`
@@ -0,0 +1,192 @@
+package conns
+
+import (
+	"errors"
+	"log"
+	"sync"
+
+	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+const (
+	// RetryModeLegacy uses the AWS SDK for Go v1 default retryer, without any client-side rate limiting.
+	RetryModeLegacy = "legacy"
+	// RetryModeStandard adds a per-service retry quota so that retries stop when a service is persistently failing.
+	RetryModeStandard = "standard"
+	// RetryModeAdaptive adds a per-service client-side request rate limiter, which backs off on throttling errors, to standard mode.
+	RetryModeAdaptive = "adaptive"
+)
+
+func RetryMode_Values() []string {
+	return []string{
+		RetryModeLegacy,
+		RetryModeStandard,
+		RetryModeAdaptive,
+	}
+}
+
+// retryModeHandlers holds the per-service retry state shared by all requests made through a session.
+// Retryers are keyed by service ID so that every resource using a service draws from the same token buckets.
+type retryModeHandlers struct {
+	mode string
+
+	retryersMutex sync.Mutex
+	retryers      map[string]awsv2.RetryerV2
+
+	tokens sync.Map // *request.Request -> *requestTokens
+}
+
+// requestTokens holds the release functions for the tokens acquired for a request's current attempt.
+type requestTokens struct {
+	attempt func(error) error
+	retry   func(error) error
+}
+
+// attemptError wraps an AWS SDK for Go v1 request error with the request's throttling classification
+// so that the AWS SDK for Go v2 retryers can act on it.
+type attemptError struct {
+	err      error
+	throttle bool
+}
+
+func (e *attemptError) Error() string {
+	return e.err.Error()
+}
+
+func (e *attemptError) Unwrap() error {
+	return e.err
+}
+
+func isAttemptErrorThrottle(err error) awsv2.Ternary {
+	var e *attemptError
+
+	if errors.As(err, &e) {
+		return awsv2.BoolTernary(e.throttle)
+	}
+
+	return awsv2.UnknownTernary
+}
+
+// registerRetryModeHandlers installs the handlers implementing the specified retry mode.
+// Handlers are installed on the session so that they are copied to every service client.
+func registerRetryModeHandlers(sess *session.Session, mode string) {
+	if mode == "" || mode == RetryModeLegacy {
+		return
+	}
+
+	h := &retryModeHandlers{
+		mode:     mode,
+		retryers: make(map[string]awsv2.RetryerV2),
+	}
+
+	sess.Handlers.Sign.PushFrontNamed(request.NamedHandler{Name: "tf.RetryModeAttemptTokenHandler", Fn: h.acquireAttemptToken})
+	sess.Handlers.CompleteAttempt.PushBackNamed(request.NamedHandler{Name: "tf.RetryModeReleaseTokensHandler", Fn: h.releaseTokens})
+	sess.Handlers.AfterRetry.PushFrontNamed(request.NamedHandler{Name: "tf.RetryModeRetryTokenHandler", Fn: h.acquireRetryToken})
+	sess.Handlers.Complete.PushBackNamed(request.NamedHandler{Name: "tf.RetryModeCleanupHandler", Fn: h.cleanup})
+}
+
+func (h *retryModeHandlers) retryer(r *request.Request) awsv2.RetryerV2 {
+	serviceID := r.ClientInfo.ServiceID
+	if serviceID == "" {
+		serviceID = r.ClientInfo.ServiceName
+	}
+
+	h.retryersMutex.Lock()
+	defer h.retryersMutex.Unlock()
+
+	if v, ok := h.retryers[serviceID]; ok {
+		return v
+	}
+
+	var retryer awsv2.RetryerV2
+
+	switch h.mode {
+	case RetryModeAdaptive:
+		retryer = retry.NewAdaptiveMode(func(o *retry.AdaptiveModeOptions) {
+			o.Throttles = append(o.Throttles, retry.IsErrorThrottleFunc(isAttemptErrorThrottle))
+		})
+	default:
+		retryer = retry.NewStandard()
+	}
+
+	h.retryers[serviceID] = retryer
+
+	return retryer
+}
+
+func (h *retryModeHandlers) requestTokens(r *request.Request) *requestTokens {
+	v, _ := h.tokens.LoadOrStore(r, &requestTokens{})
+
+	return v.(*requestTokens)
+}
+
+// acquireAttemptToken is run before each attempt is signed.
+// In adaptive mode it blocks until the service's client-side rate limiter allows the attempt.
+func (h *retryModeHandlers) acquireAttemptToken(r *request.Request) {
+	release, err := h.retryer(r).GetAttemptToken(r.Context())
+
+	if err != nil {
+		r.Error = awserr.New(request.CanceledErrorCode, "unable to acquire request attempt token", err)
+		return
+	}
+
+	h.requestTokens(r).attempt = release
+}
+
+// releaseTokens is run after each attempt, successful or not, and feeds the attempt's outcome back to the retryer.
+func (h *retryModeHandlers) releaseTokens(r *request.Request) {
+	v, ok := h.tokens.Load(r)
+
+	if !ok {
+		return
+	}
+
+	tokens := v.(*requestTokens)
+
+	var err error
+	if r.Error != nil {
+		err = &attemptError{err: r.Error, throttle: r.IsErrorThrottle()}
+	}
+
+	if tokens.attempt != nil {
+		tokens.attempt(err)
+		tokens.attempt = nil
+	}
+
+	if tokens.retry != nil {
+		tokens.retry(err)
+		tokens.retry = nil
+	}
+}
+
+// acquireRetryToken is run before the AWS SDK for Go v1 decides whether to retry a failed attempt.
+// Retries are only allowed while the service's retry quota has tokens available.
+func (h *retryModeHandlers) acquireRetryToken(r *request.Request) {
+	// Mirror the core AfterRetryHandler so that the retry decision made here is the one it acts on.
+	if r.Retryable == nil {
+		r.Retryable = aws.Bool(r.ShouldRetry(r))
+	}
+
+	if !r.WillRetry() {
+		return
+	}
+
+	release, err := h.retryer(r).GetRetryToken(r.Context(), &attemptError{err: r.Error, throttle: r.IsErrorThrottle()})
+
+	if err != nil {
+		log.Printf("[WARN] Not retrying %s %s request: %s", r.ClientInfo.ServiceID, r.Operation.Name, err)
+		r.Retryable = aws.Bool(false)
+		return
+	}
+
+	h.requestTokens(r).retry = release
+}
+
+func (h *retryModeHandlers) cleanup(r *request.Request) {
+	h.tokens.Delete(r)
+}
@@ -0,0 +1,95 @@
+package conns
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+)
+
+func TestRegisterRetryModeHandlers(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		RetryMode        string
+		MaxRetries       int
+		ExpectedAttempts int32
+	}{
+		{
+			Name:             "unset",
+			MaxRetries:       200,
+			ExpectedAttempts: 201,
+		},
+		{
+			Name:             "legacy",
+			RetryMode:        RetryModeLegacy,
+			MaxRetries:       200,
+			ExpectedAttempts: 201,
+		},
+		{
+			Name:             "standard retry quota exhausted",
+			RetryMode:        RetryModeStandard,
+			MaxRetries:       200,
+			ExpectedAttempts: 101,
+		},
+		{
+			Name:             "adaptive",
+			RetryMode:        RetryModeAdaptive,
+			MaxRetries:       3,
+			ExpectedAttempts: 4,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var attempts int32
+
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&attempts, 1)
+
+				w.Header().Set("Content-Type", "text/xml")
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(testThrottlingErrorResponse)) //nolint:errcheck
+			}))
+			defer ts.Close()
+
+			sess, err := session.NewSession(&aws.Config{
+				Credentials: credentials.NewStaticCredentials("MockAccessKey", "MockSecretKey", ""),
+				Endpoint:    aws.String(ts.URL),
+				MaxRetries:  aws.Int(testCase.MaxRetries),
+				Region:      aws.String("us-east-1"), //lintignore:AWSAT003
+				SleepDelay:  func(time.Duration) {},
+			})
+			if err != nil {
+				t.Fatalf("unexpected error creating session: %s", err)
+			}
+
+			registerRetryModeHandlers(sess, testCase.RetryMode)
+
+			_, err = sts.New(sess).GetCallerIdentity(&sts.GetCallerIdentityInput{})
+
+			if !tfawserr.ErrCodeEquals(err, "Throttling") {
+				t.Fatalf("expected Throttling error, got: %v", err)
+			}
+
+			if got := atomic.LoadInt32(&attempts); got != testCase.ExpectedAttempts {
+				t.Errorf("got %d attempts, expected %d", got, testCase.ExpectedAttempts)
+			}
+		})
+	}
+}
+
+const testThrottlingErrorResponse = `<ErrorResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <Error>
+    <Type>Sender</Type>
+    <Code>Throttling</Code>
+    <Message>Rate exceeded</Message>
+  </Error>
+  <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+</ErrorResponse>`
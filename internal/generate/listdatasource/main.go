@@ -0,0 +1,300 @@
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"regexp"
+	"strings"
+	"text/template"
+)
+
+var (
+	name          = flag.String("Name", "", "name of the data source, e.g. Functions")
+	listOp        = flag.String("ListOp", "", "name of the AWS SDK list operation, e.g. ListFunctions")
+	listOutElem   = flag.String("ListOutElem", "", "name of the list operation output field containing the items")
+	idElem        = flag.String("IDElem", "", "name of the item field containing the identifier (omit if the items are identifiers)")
+	nameElem      = flag.String("NameElem", "", "name of the item field containing the name (omit to derive the name from the identifier)")
+	idAttribName  = flag.String("IDAttribName", "arns", "name of the attribute holding the identifiers")
+	humanFriendly = flag.String("HumanFriendly", "", "human friendly name of the listed resources used in error messages")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+type TemplateData struct {
+	AWSService      string
+	AWSServiceUpper string
+	ServicePackage  string
+	Parameters      string
+
+	Name          string
+	ListOp        string
+	ListOutElem   string
+	IDElem        string
+	NameElem      string
+	IDAttribName  string
+	HumanFriendly string
+}
+
+func main() {
+	log.SetFlags(0)
+	flag.Usage = usage
+	flag.Parse()
+
+	if *name == "" || *listOp == "" || *listOutElem == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	servicePackage := os.Getenv("GOPACKAGE")
+	awsService, err := awsServiceName(servicePackage)
+
+	if err != nil {
+		log.Fatalf("encountered: %s", err)
+	}
+
+	awsServiceUpper, err := awsServiceNameUpper(servicePackage)
+
+	if err != nil {
+		log.Fatalf("encountered: %s", err)
+	}
+
+	templateData := TemplateData{
+		AWSService:      awsService,
+		AWSServiceUpper: awsServiceUpper,
+		ServicePackage:  servicePackage,
+		Parameters:      strings.Join(os.Args[1:], " "),
+		Name:            *name,
+		ListOp:          *listOp,
+		ListOutElem:     *listOutElem,
+		IDElem:          *idElem,
+		NameElem:        *nameElem,
+		IDAttribName:    *idAttribName,
+		HumanFriendly:   *humanFriendly,
+	}
+
+	if templateData.HumanFriendly == "" {
+		templateData.HumanFriendly = fmt.Sprintf("%s %s", awsServiceUpper, *name)
+	}
+
+	filename := fmt.Sprintf("%s_data_source_gen.go", toSnakeCase(*name))
+
+	if err := generateTemplateFile(filename, dataSourceTemplateBody, templateData); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func generateTemplateFile(filename string, templateBody string, templateData interface{}) error {
+	tmpl, err := template.New(filename).Parse(templateBody)
+
+	if err != nil {
+		return fmt.Errorf("error parsing template: %w", err)
+	}
+
+	var buffer bytes.Buffer
+	err = tmpl.Execute(&buffer, templateData)
+
+	if err != nil {
+		return fmt.Errorf("error executing template: %w", err)
+	}
+
+	generatedFileContents, err := format.Source(buffer.Bytes())
+
+	if err != nil {
+		return fmt.Errorf("error formatting generated file: %w", err)
+	}
+
+	f, err := os.Create(filename)
+
+	if err != nil {
+		return fmt.Errorf("error creating file (%s): %w", filename, err)
+	}
+
+	defer f.Close()
+
+	_, err = f.Write(generatedFileContents)
+
+	if err != nil {
+		return fmt.Errorf("error writing to file (%s): %w", filename, err)
+	}
+
+	return nil
+}
+
+var snakeCaseRegexp = regexp.MustCompile("([a-z0-9])([A-Z])")
+
+func toSnakeCase(s string) string {
+	return strings.ToLower(snakeCaseRegexp.ReplaceAllString(s, "${1}_${2}"))
+}
+
+const dataSourceTemplateBody = `
+// Code generated by "internal/generate/listdatasource/main.go {{ .Parameters }}"; DO NOT EDIT.
+
+package {{ .ServicePackage }}
+
+import (
+	"fmt"
+	"regexp"
+	{{- if not .NameElem }}
+	"strings"
+	{{- end }}
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/{{ .AWSService }}"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func DataSource{{ .Name }}() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSource{{ .Name }}Read,
+
+		Schema: map[string]*schema.Schema{
+			"{{ .IDAttribName }}": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": tftags.TagsSchema(),
+		},
+	}
+}
+
+func dataSource{{ .Name }}Read(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).{{ .AWSServiceUpper }}Conn
+
+	var nameRegex *regexp.Regexp
+
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+
+	tagsToMatch := tftags.New(d.Get("tags").(map[string]interface{})).IgnoreAWS()
+
+	input := &{{ .AWSService }}.{{ .ListOp }}Input{}
+
+	var ids, names []string
+	var tagsErr error
+
+	err := conn.{{ .ListOp }}Pages(input, func(page *{{ .AWSService }}.{{ .ListOp }}Output, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.{{ .ListOutElem }} {
+			if v == nil {
+				continue
+			}
+
+			{{ if .IDElem -}}
+			id := aws.StringValue(v.{{ .IDElem }})
+			{{- else -}}
+			id := aws.StringValue(v)
+			{{- end }}
+			{{- if .NameElem }}
+			name := aws.StringValue(v.{{ .NameElem }})
+			{{- else }}
+			name := id[strings.LastIndexAny(id, ":/")+1:]
+			{{- end }}
+
+			if nameRegex != nil && !nameRegex.MatchString(name) {
+				continue
+			}
+
+			if len(tagsToMatch) > 0 {
+				tags, err := ListTags(conn, id)
+
+				if err != nil {
+					tagsErr = fmt.Errorf("error listing tags for %s: %w", id, err)
+					return false
+				}
+
+				if !tags.ContainsAll(tagsToMatch) {
+					continue
+				}
+			}
+
+			ids = append(ids, id)
+			names = append(names, name)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading {{ .HumanFriendly }}: %w", err)
+	}
+
+	if tagsErr != nil {
+		return tagsErr
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+
+	if err := d.Set("{{ .IDAttribName }}", ids); err != nil {
+		return fmt.Errorf("error setting {{ .IDAttribName }}: %w", err)
+	}
+
+	if err := d.Set("names", names); err != nil {
+		return fmt.Errorf("error setting names: %w", err)
+	}
+
+	return nil
+}
+`
+
+var awsServiceNames map[string]string
+
+func init() {
+	awsServiceNames = make(map[string]string)
+
+	awsServiceNames["acm"] = "ACM"
+	awsServiceNames["ecs"] = "ECS"
+	awsServiceNames["elbv2"] = "ELBV2"
+	awsServiceNames["lambda"] = "Lambda"
+	awsServiceNames["sns"] = "SNS"
+	awsServiceNames["sqs"] = "SQS"
+}
+
+func awsServiceName(s string) (string, error) {
+	s = strings.ToLower(s)
+
+	if _, ok := awsServiceNames[s]; ok {
+		return s, nil
+	}
+
+	return "", fmt.Errorf("unable to find AWS service name for %s", s)
+}
+
+func awsServiceNameUpper(s string) (string, error) {
+	s = strings.ToLower(s)
+
+	if v, ok := awsServiceNames[s]; ok {
+		return v, nil
+	}
+
+	return "", fmt.Errorf("unable to find AWS service name for %s", s)
+}
@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"runtime"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+const (
+	servicePackagePathPrefix = "github.com/hashicorp/terraform-provider-aws/internal/service/"
+)
+
+// wrapConcurrencyLimits wraps the CRUD functions of each resource so that they wait on the
+// service's semaphore, if one is configured via the provider's concurrency_limits block.
+// The service is the name of the package implementing the resource, which matches the service key.
+func wrapConcurrencyLimits(resources map[string]*schema.Resource) {
+	for _, r := range resources {
+		service := resourceService(r)
+
+		if service == "" {
+			continue
+		}
+
+		if r.Create != nil {
+			r.Create = schema.CreateFunc(withConcurrencyLimit(service, r.Create))
+		}
+		if r.Read != nil {
+			r.Read = schema.ReadFunc(withConcurrencyLimit(service, r.Read))
+		}
+		if r.Update != nil {
+			r.Update = schema.UpdateFunc(withConcurrencyLimit(service, r.Update))
+		}
+		if r.Delete != nil {
+			r.Delete = schema.DeleteFunc(withConcurrencyLimit(service, r.Delete))
+		}
+
+		if r.CreateContext != nil {
+			r.CreateContext = schema.CreateContextFunc(withConcurrencyLimitContext(service, r.CreateContext))
+		}
+		if r.ReadContext != nil {
+			r.ReadContext = schema.ReadContextFunc(withConcurrencyLimitContext(service, r.ReadContext))
+		}
+		if r.UpdateContext != nil {
+			r.UpdateContext = schema.UpdateContextFunc(withConcurrencyLimitContext(service, r.UpdateContext))
+		}
+		if r.DeleteContext != nil {
+			r.DeleteContext = schema.DeleteContextFunc(withConcurrencyLimitContext(service, r.DeleteContext))
+		}
+
+		if r.CreateWithoutTimeout != nil {
+			r.CreateWithoutTimeout = schema.CreateContextFunc(withConcurrencyLimitContext(service, r.CreateWithoutTimeout))
+		}
+		if r.ReadWithoutTimeout != nil {
+			r.ReadWithoutTimeout = schema.ReadContextFunc(withConcurrencyLimitContext(service, r.ReadWithoutTimeout))
+		}
+		if r.UpdateWithoutTimeout != nil {
+			r.UpdateWithoutTimeout = schema.UpdateContextFunc(withConcurrencyLimitContext(service, r.UpdateWithoutTimeout))
+		}
+		if r.DeleteWithoutTimeout != nil {
+			r.DeleteWithoutTimeout = schema.DeleteContextFunc(withConcurrencyLimitContext(service, r.DeleteWithoutTimeout))
+		}
+	}
+}
+
+// resourceService returns the service key for a resource, derived from the package of its Read function.
+func resourceService(r *schema.Resource) string {
+	var f interface{}
+
+	switch {
+	case r.Read != nil:
+		f = r.Read
+	case r.ReadContext != nil:
+		f = r.ReadContext
+	case r.ReadWithoutTimeout != nil:
+		f = r.ReadWithoutTimeout
+	default:
+		return ""
+	}
+
+	fn := runtime.FuncForPC(reflect.ValueOf(f).Pointer())
+
+	if fn == nil {
+		return ""
+	}
+
+	name := fn.Name()
+
+	if !strings.HasPrefix(name, servicePackagePathPrefix) {
+		return ""
+	}
+
+	name = strings.TrimPrefix(name, servicePackagePathPrefix)
+
+	if i := strings.Index(name, "."); i > 0 {
+		return name[:i]
+	}
+
+	return ""
+}
+
+func withConcurrencyLimit(service string, f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		if client, ok := meta.(*conns.AWSClient); ok {
+			if semaphore, ok := client.ConcurrencySemaphore(service); ok {
+				semaphore.Wait()
+				defer semaphore.Notify()
+			}
+		}
+
+		return f(d, meta)
+	}
+}
+
+func withConcurrencyLimitContext(service string, f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if client, ok := meta.(*conns.AWSClient); ok {
+			if semaphore, ok := client.ConcurrencySemaphore(service); ok {
+				if err := semaphore.WaitContext(ctx); err != nil {
+					return diag.Errorf("error waiting for %s concurrency limit: %s", service, err)
+				}
+				defer semaphore.Notify()
+			}
+		}
+
+		return f(ctx, d, meta)
+	}
+}
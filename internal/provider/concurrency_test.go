@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/route53"
+)
+
+func TestResourceService(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Resource *schema.Resource
+		Expected string
+	}{
+		{
+			Name:     "aws_organizations_account",
+			Resource: organizations.ResourceAccount(),
+			Expected: conns.Organizations,
+		},
+		{
+			Name:     "aws_route53_record",
+			Resource: route53.ResourceRecord(),
+			Expected: conns.Route53,
+		},
+		{
+			Name:     "aws_vpc",
+			Resource: ec2.ResourceVPC(),
+			Expected: conns.EC2,
+		},
+		{
+			Name:     "no read function",
+			Resource: &schema.Resource{},
+			Expected: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := resourceService(testCase.Resource); got != testCase.Expected {
+				t.Errorf("got %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestWithConcurrencyLimit(t *testing.T) {
+	testCases := []struct {
+		Name                  string
+		Service               string
+		ExpectedMaxConcurrent int32
+	}{
+		{
+			Name:                  "limited",
+			Service:               conns.Route53,
+			ExpectedMaxConcurrent: 2,
+		},
+		{
+			Name:                  "unlimited",
+			Service:               conns.EC2,
+			ExpectedMaxConcurrent: 10,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var concurrent, maxConcurrent int32
+
+			f := withConcurrencyLimit(testCase.Service, func(d *schema.ResourceData, meta interface{}) error {
+				n := atomic.AddInt32(&concurrent, 1)
+				defer atomic.AddInt32(&concurrent, -1)
+
+				for {
+					max := atomic.LoadInt32(&maxConcurrent)
+					if n <= max || atomic.CompareAndSwapInt32(&maxConcurrent, max, n) {
+						break
+					}
+				}
+
+				time.Sleep(50 * time.Millisecond)
+
+				return nil
+			})
+
+			client := &conns.AWSClient{
+				ConcurrencySemaphores: map[string]tfsync.Semaphore{
+					conns.Route53: make(tfsync.Semaphore, 2),
+				},
+			}
+
+			var wg sync.WaitGroup
+
+			for i := 0; i < 10; i++ {
+				wg.Add(1)
+
+				go func() {
+					defer wg.Done()
+
+					if err := f(nil, client); err != nil {
+						t.Errorf("unexpected error: %s", err)
+					}
+				}()
+			}
+
+			wg.Wait()
+
+			if got := atomic.LoadInt32(&maxConcurrent); got != testCase.ExpectedMaxConcurrent {
+				t.Errorf("got %d maximum concurrent calls, expected %d", got, testCase.ExpectedMaxConcurrent)
+			}
+		})
+	}
+}
@@ -119,6 +119,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/neptune"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/networkfirewall"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/networkmanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/opensearch"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/opsworks"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/outposts"
@@ -194,7 +195,9 @@ func Provider() *schema.Provider {
 				ConflictsWith: []string{"forbidden_account_ids"},
 				Set:           schema.HashString,
 			},
-			"assume_role": assumeRoleSchema(),
+			"assume_role":                   assumeRoleSchema(),
+			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+			"concurrency_limits":            concurrencyLimitsSchema(),
 			"custom_ca_bundle": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -296,6 +299,58 @@ func Provider() *schema.Provider {
 				Description: "The region where AWS operations will take place. Examples\n" +
 					"are us-east-1, us-west-2, etc.", // lintignore:AWSAT003,
 			},
+			"required_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to require resource tags across all resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"exempt_resource_types": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource types, which may contain wildcards, not required to have any of the tags.",
+						},
+						"tag": {
+							Type:        schema.TypeList,
+							Required:    true,
+							MinItems:    1,
+							Description: "Tags required across all resources.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"allowed_values": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringIsValidRegExp},
+										Description: "Regular expressions, one of which the whole tag value must match.",
+									},
+									"exempt_resource_types": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Resource types, which may contain wildcards, not required to have the tag.",
+									},
+									"key": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+										Description:  "Tag key.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"retry_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(conns.RetryMode_Values(), false),
+				Description: "Specifies how retries are attempted. Valid values are `legacy`, `standard`, and `adaptive`.\n" +
+					"`standard` limits retries per service with a retry quota. `adaptive` additionally\n" +
+					"rate limits requests per service on the client when AWS throttles requests.",
+			},
 			"s3_force_path_style": {
 				Type:       schema.TypeBool,
 				Optional:   true,
@@ -407,7 +462,8 @@ func Provider() *schema.Provider {
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"aws_acm_certificate": acm.DataSourceCertificate(),
+			"aws_acm_certificate":  acm.DataSourceCertificate(),
+			"aws_acm_certificates": acm.DataSourceCertificates(),
 
 			"aws_acmpca_certificate_authority": acmpca.DataSourceCertificateAuthority(),
 			"aws_acmpca_certificate":           acmpca.DataSourceCertificate(),
@@ -584,6 +640,7 @@ func Provider() *schema.Provider {
 			"aws_ecrpublic_authorization_token": ecrpublic.DataSourceAuthorizationToken(),
 
 			"aws_ecs_cluster":              ecs.DataSourceCluster(),
+			"aws_ecs_clusters":             ecs.DataSourceClusters(),
 			"aws_ecs_container_definition": ecs.DataSourceContainerDefinition(),
 			"aws_ecs_service":              ecs.DataSourceService(),
 			"aws_ecs_task_definition":      ecs.DataSourceTaskDefinition(),
@@ -615,12 +672,14 @@ func Provider() *schema.Provider {
 			"aws_elb_service_account": elb.DataSourceServiceAccount(),
 
 			// Adding the Aliases for the ALB -> LB Rename
-			"aws_alb_listener":     elbv2.DataSourceListener(),
-			"aws_alb_target_group": elbv2.DataSourceTargetGroup(),
-			"aws_alb":              elbv2.DataSourceLoadBalancer(),
-			"aws_lb_listener":      elbv2.DataSourceListener(),
-			"aws_lb_target_group":  elbv2.DataSourceTargetGroup(),
-			"aws_lb":               elbv2.DataSourceLoadBalancer(),
+			"aws_alb_listener":      elbv2.DataSourceListener(),
+			"aws_alb_target_group":  elbv2.DataSourceTargetGroup(),
+			"aws_alb_target_groups": elbv2.DataSourceTargetGroups(),
+			"aws_alb":               elbv2.DataSourceLoadBalancer(),
+			"aws_lb_listener":       elbv2.DataSourceListener(),
+			"aws_lb_target_group":   elbv2.DataSourceTargetGroup(),
+			"aws_lb_target_groups":  elbv2.DataSourceTargetGroups(),
+			"aws_lb":                elbv2.DataSourceLoadBalancer(),
 
 			"aws_emr_release_labels": emr.DataSourceReleaseLabels(),
 
@@ -642,6 +701,7 @@ func Provider() *schema.Provider {
 			"aws_iam_openid_connect_provider": iam.DataSourceOpenIDConnectProvider(),
 			"aws_iam_policy":                  iam.DataSourcePolicy(),
 			"aws_iam_policy_document":         iam.DataSourcePolicyDocument(),
+			"aws_iam_policy_evaluation":       iam.DataSourcePolicyEvaluation(),
 			"aws_iam_role":                    iam.DataSourceRole(),
 			"aws_iam_roles":                   iam.DataSourceRoles(),
 			"aws_iam_server_certificate":      iam.DataSourceServerCertificate(),
@@ -695,6 +755,7 @@ func Provider() *schema.Provider {
 			"aws_lambda_alias":               lambda.DataSourceAlias(),
 			"aws_lambda_code_signing_config": lambda.DataSourceCodeSigningConfig(),
 			"aws_lambda_function":            lambda.DataSourceFunction(),
+			"aws_lambda_functions":           lambda.DataSourceFunctions(),
 			"aws_lambda_invocation":          lambda.DataSourceInvocation(),
 			"aws_lambda_layer_version":       lambda.DataSourceLayerVersion(),
 
@@ -728,6 +789,8 @@ func Provider() *schema.Provider {
 			"aws_networkmanager_site":            networkmanager.DataSourceSite(),
 			"aws_networkmanager_sites":           networkmanager.DataSourceSites(),
 
+			"aws_opensearch_domain": opensearch.DataSourceDomain(),
+
 			"aws_organizations_delegated_administrators": organizations.DataSourceDelegatedAdministrators(),
 			"aws_organizations_delegated_services":       organizations.DataSourceDelegatedServices(),
 			"aws_organizations_organization":             organizations.DataSourceOrganization(),
@@ -769,6 +832,7 @@ func Provider() *schema.Provider {
 			"aws_resourcegroupstaggingapi_resources": resourcegroupstaggingapi.DataSourceResources(),
 
 			"aws_route53_delegation_set": route53.DataSourceDelegationSet(),
+			"aws_route53_records":        route53.DataSourceRecords(),
 			"aws_route53_zone":           route53.DataSourceZone(),
 
 			"aws_route53_resolver_endpoint": route53resolver.DataSourceEndpoint(),
@@ -807,9 +871,11 @@ func Provider() *schema.Provider {
 			"aws_signer_signing_job":     signer.DataSourceSigningJob(),
 			"aws_signer_signing_profile": signer.DataSourceSigningProfile(),
 
-			"aws_sns_topic": sns.DataSourceTopic(),
+			"aws_sns_topic":  sns.DataSourceTopic(),
+			"aws_sns_topics": sns.DataSourceTopics(),
 
-			"aws_sqs_queue": sqs.DataSourceQueue(),
+			"aws_sqs_queue":  sqs.DataSourceQueue(),
+			"aws_sqs_queues": sqs.DataSourceQueues(),
 
 			"aws_ssm_document":           ssm.DataSourceDocument(),
 			"aws_ssm_instances":          ssm.DataSourceInstances(),
@@ -1183,10 +1249,12 @@ func Provider() *schema.Provider {
 			"aws_directory_service_directory":             ds.ResourceDirectory(),
 			"aws_directory_service_log_subscription":      ds.ResourceLogSubscription(),
 
+			"aws_dynamodb_contributor_insights":          dynamodb.ResourceContributorInsights(),
 			"aws_dynamodb_global_table":                  dynamodb.ResourceGlobalTable(),
 			"aws_dynamodb_kinesis_streaming_destination": dynamodb.ResourceKinesisStreamingDestination(),
 			"aws_dynamodb_table":                         dynamodb.ResourceTable(),
 			"aws_dynamodb_table_item":                    dynamodb.ResourceTableItem(),
+			"aws_dynamodb_table_replica":                 dynamodb.ResourceTableReplica(),
 			"aws_dynamodb_tag":                           dynamodb.ResourceTag(),
 
 			"aws_ami":                                              ec2.ResourceAMI(),
@@ -1215,6 +1283,7 @@ func Provider() *schema.Provider {
 			"aws_ec2_client_vpn_route":                             ec2.ResourceClientVPNRoute(),
 			"aws_ec2_fleet":                                        ec2.ResourceFleet(),
 			"aws_ec2_host":                                         ec2.ResourceHost(),
+			"aws_ec2_instance_state":                               ec2.ResourceInstanceState(),
 			"aws_ec2_local_gateway_route":                          ec2.ResourceLocalGatewayRoute(),
 			"aws_ec2_local_gateway_route_table_vpc_association":    ec2.ResourceLocalGatewayRouteTableVPCAssociation(),
 			"aws_ec2_managed_prefix_list":                          ec2.ResourceManagedPrefixList(),
@@ -1516,9 +1585,11 @@ func Provider() *schema.Provider {
 
 			"aws_kms_alias":                kms.ResourceAlias(),
 			"aws_kms_ciphertext":           kms.ResourceCiphertext(),
+			"aws_kms_custom_key_store":     kms.ResourceCustomKeyStore(),
 			"aws_kms_external_key":         kms.ResourceExternalKey(),
 			"aws_kms_grant":                kms.ResourceGrant(),
 			"aws_kms_key":                  kms.ResourceKey(),
+			"aws_kms_key_policy":           kms.ResourceKeyPolicy(),
 			"aws_kms_replica_external_key": kms.ResourceReplicaExternalKey(),
 			"aws_kms_replica_key":          kms.ResourceReplicaKey(),
 
@@ -1545,12 +1616,22 @@ func Provider() *schema.Provider {
 			"aws_licensemanager_association":           licensemanager.ResourceAssociation(),
 			"aws_licensemanager_license_configuration": licensemanager.ResourceLicenseConfiguration(),
 
-			"aws_lightsail_domain":                lightsail.ResourceDomain(),
-			"aws_lightsail_instance":              lightsail.ResourceInstance(),
-			"aws_lightsail_instance_public_ports": lightsail.ResourceInstancePublicPorts(),
-			"aws_lightsail_key_pair":              lightsail.ResourceKeyPair(),
-			"aws_lightsail_static_ip":             lightsail.ResourceStaticIP(),
-			"aws_lightsail_static_ip_attachment":  lightsail.ResourceStaticIPAttachment(),
+			"aws_lightsail_bucket":                               lightsail.ResourceBucket(),
+			"aws_lightsail_bucket_access_key":                    lightsail.ResourceBucketAccessKey(),
+			"aws_lightsail_certificate":                          lightsail.ResourceCertificate(),
+			"aws_lightsail_container_service":                    lightsail.ResourceContainerService(),
+			"aws_lightsail_container_service_deployment_version": lightsail.ResourceContainerServiceDeploymentVersion(),
+			"aws_lightsail_database":                             lightsail.ResourceDatabase(),
+			"aws_lightsail_domain":                               lightsail.ResourceDomain(),
+			"aws_lightsail_instance":                             lightsail.ResourceInstance(),
+			"aws_lightsail_instance_public_ports":                lightsail.ResourceInstancePublicPorts(),
+			"aws_lightsail_key_pair":                             lightsail.ResourceKeyPair(),
+			"aws_lightsail_lb":                                   lightsail.ResourceLoadBalancer(),
+			"aws_lightsail_lb_attachment":                        lightsail.ResourceLoadBalancerAttachment(),
+			"aws_lightsail_lb_certificate":                       lightsail.ResourceLoadBalancerCertificate(),
+			"aws_lightsail_lb_certificate_attachment":            lightsail.ResourceLoadBalancerCertificateAttachment(),
+			"aws_lightsail_static_ip":                            lightsail.ResourceStaticIP(),
+			"aws_lightsail_static_ip_attachment":                 lightsail.ResourceStaticIPAttachment(),
 
 			"aws_macie_member_account_association": macie.ResourceMemberAccountAssociation(),
 			"aws_macie_s3_bucket_association":      macie.ResourceS3BucketAssociation(),
@@ -1607,6 +1688,14 @@ func Provider() *schema.Provider {
 			"aws_networkmanager_transit_gateway_connect_peer_association": networkmanager.ResourceTransitGatewayConnectPeerAssociation(),
 			"aws_networkmanager_transit_gateway_registration":             networkmanager.ResourceTransitGatewayRegistration(),
 
+			"aws_opensearch_domain":                      opensearch.ResourceDomain(),
+			"aws_opensearch_domain_policy":               opensearch.ResourceDomainPolicy(),
+			"aws_opensearch_domain_saml_options":         opensearch.ResourceDomainSAMLOptions(),
+			"aws_opensearch_inbound_connection_accepter": opensearch.ResourceInboundConnectionAccepter(),
+			"aws_opensearch_outbound_connection":         opensearch.ResourceOutboundConnection(),
+			"aws_opensearch_package":                     opensearch.ResourcePackage(),
+			"aws_opensearch_package_association":         opensearch.ResourcePackageAssociation(),
+
 			"aws_opsworks_application":       opsworks.ResourceApplication(),
 			"aws_opsworks_custom_layer":      opsworks.ResourceCustomLayer(),
 			"aws_opsworks_ecs_cluster_layer": opsworks.ResourceECSClusterLayer(),
@@ -1646,9 +1735,15 @@ func Provider() *schema.Provider {
 
 			"aws_qldb_ledger": qldb.ResourceLedger(),
 
+			"aws_quicksight_analysis":         quicksight.ResourceAnalysis(),
+			"aws_quicksight_dashboard":        quicksight.ResourceDashboard(),
+			"aws_quicksight_data_set":         quicksight.ResourceDataSet(),
 			"aws_quicksight_data_source":      quicksight.ResourceDataSource(),
+			"aws_quicksight_folder":           quicksight.ResourceFolder(),
 			"aws_quicksight_group":            quicksight.ResourceGroup(),
 			"aws_quicksight_group_membership": quicksight.ResourceGroupMembership(),
+			"aws_quicksight_template":         quicksight.ResourceTemplate(),
+			"aws_quicksight_theme":            quicksight.ResourceTheme(),
 			"aws_quicksight_user":             quicksight.ResourceUser(),
 
 			"aws_ram_principal_association":   ram.ResourcePrincipalAssociation(),
@@ -1694,6 +1789,8 @@ func Provider() *schema.Provider {
 			"aws_route53_key_signing_key":               route53.ResourceKeySigningKey(),
 			"aws_route53_query_log":                     route53.ResourceQueryLog(),
 			"aws_route53_record":                        route53.ResourceRecord(),
+			"aws_route53_traffic_policy":                route53.ResourceTrafficPolicy(),
+			"aws_route53_traffic_policy_instance":       route53.ResourceTrafficPolicyInstance(),
 			"aws_route53_vpc_association_authorization": route53.ResourceVPCAssociationAuthorization(),
 			"aws_route53_zone":                          route53.ResourceZone(),
 			"aws_route53_zone_association":              route53.ResourceZoneAssociation(),
@@ -1951,6 +2048,10 @@ func Provider() *schema.Provider {
 		},
 	}
 
+	wrapConcurrencyLimits(provider.ResourcesMap)
+	wrapConcurrencyLimits(provider.DataSourcesMap)
+	wrapResourceTypeCustomizeDiff(provider.ResourcesMap)
+
 	provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		terraformVersion := provider.TerraformVersion
 		if terraformVersion == "" {
@@ -1978,6 +2079,7 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, terraformVer
 		MaxRetries:                     d.Get("max_retries").(int),
 		Profile:                        d.Get("profile").(string),
 		Region:                         d.Get("region").(string),
+		RetryMode:                      d.Get("retry_mode").(string),
 		S3UsePathStyle:                 d.Get("s3_use_path_style").(bool) || d.Get("s3_force_path_style").(bool),
 		SecretKey:                      d.Get("secret_key").(string),
 		SkipCredsValidation:            d.Get("skip_credentials_validation").(bool),
@@ -2016,6 +2118,31 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, terraformVer
 		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q)", config.AssumeRole.RoleARN, config.AssumeRole.SessionName, config.AssumeRole.ExternalID)
 	}
 
+	if l, ok := d.Get("assume_role_with_web_identity").([]interface{}); ok && len(l) > 0 && l[0] != nil {
+		config.AssumeRoleWithWebIdentity = expandAssumeRoleWithWebIdentity(l[0].(map[string]interface{}))
+		log.Printf("[INFO] assume_role_with_web_identity configuration set: (ARN: %q, SessionID: %q)", config.AssumeRoleWithWebIdentity.RoleARN, config.AssumeRoleWithWebIdentity.SessionName)
+	}
+
+	if v, ok := d.GetOk("concurrency_limits"); ok && v.(*schema.Set).Len() > 0 {
+		concurrencyLimits, err := expandConcurrencyLimits(v.(*schema.Set).List())
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		config.ConcurrencyLimits = concurrencyLimits
+	}
+
+	if v, ok := d.GetOk("required_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		requiredTagsConfig, err := expandProviderRequiredTags(v.([]interface{})[0].(map[string]interface{}))
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		config.RequiredTagsConfig = requiredTagsConfig
+	}
+
 	endpointsSet := d.Get("endpoints").(*schema.Set)
 	if err := expandEndpoints(endpointsSet.List(), config.Endpoints); err != nil {
 		return nil, diag.FromErr(err)
@@ -2114,6 +2241,92 @@ func assumeRoleSchema() *schema.Schema {
 	}
 }
 
+func assumeRoleWithWebIdentitySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"duration": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "The duration, between 15 minutes and 12 hours, of the role session. Valid time units are ns, us (or µs), ms, s, h, or m.",
+					ValidateFunc: ValidAssumeRoleDuration,
+				},
+				"policy": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "IAM Policy JSON describing further restricting permissions for the IAM Role being assumed.",
+					ValidateFunc: validation.StringIsJSON,
+				},
+				"policy_arns": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "Amazon Resource Names (ARNs) of IAM Policies describing further restricting permissions for the IAM Role being assumed.",
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: verify.ValidARN,
+					},
+				},
+				"role_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "Amazon Resource Name of an IAM Role to assume prior to making API calls.",
+					ValidateFunc: verify.ValidARN,
+				},
+				"session_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "An identifier for the assumed role session.",
+					ValidateFunc: validation.All(
+						validation.StringLenBetween(2, 64),
+						validation.StringMatch(regexp.MustCompile(`[\w+=,.@\-]*`), ""),
+					),
+				},
+				"web_identity_token": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Sensitive:    true,
+					Description:  "The OAuth 2.0 access token or OpenID Connect ID token provided by the identity provider.",
+					ValidateFunc: validation.StringLenBetween(4, 20000),
+					ExactlyOneOf: []string{"assume_role_with_web_identity.0.web_identity_token", "assume_role_with_web_identity.0.web_identity_token_file"},
+				},
+				"web_identity_token_file": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "File containing the OAuth 2.0 access token or OpenID Connect ID token provided by the identity provider. The file is read each time credentials are refreshed.",
+					ExactlyOneOf: []string{"assume_role_with_web_identity.0.web_identity_token", "assume_role_with_web_identity.0.web_identity_token_file"},
+				},
+			},
+		},
+	}
+}
+
+func concurrencyLimitsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: "Limits on the number of concurrent create, read, update and delete operations for resources of a service.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"limit": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					Description:  "The maximum number of concurrent operations.",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"service": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "The service, using the same names as the endpoints block.",
+					ValidateFunc: validation.StringInSlice(conns.HCLKeys(), false),
+				},
+			},
+		},
+	}
+}
+
 func endpointsSchema() *schema.Schema {
 	endpointsAttributes := make(map[string]*schema.Schema)
 
@@ -2204,6 +2417,49 @@ func expandAssumeRole(m map[string]interface{}) *awsbase.AssumeRole {
 	return &assumeRole
 }
 
+func expandAssumeRoleWithWebIdentity(m map[string]interface{}) *conns.AssumeRoleWithWebIdentity {
+	assumeRole := conns.AssumeRoleWithWebIdentity{}
+
+	if v, ok := m["duration"].(string); ok && v != "" {
+		duration, _ := time.ParseDuration(v)
+		assumeRole.Duration = duration
+	}
+
+	if v, ok := m["policy"].(string); ok && v != "" {
+		assumeRole.Policy = v
+	}
+
+	if policyARNSet, ok := m["policy_arns"].(*schema.Set); ok && policyARNSet.Len() > 0 {
+		for _, policyARNRaw := range policyARNSet.List() {
+			policyARN, ok := policyARNRaw.(string)
+
+			if !ok {
+				continue
+			}
+
+			assumeRole.PolicyARNs = append(assumeRole.PolicyARNs, policyARN)
+		}
+	}
+
+	if v, ok := m["role_arn"].(string); ok && v != "" {
+		assumeRole.RoleARN = v
+	}
+
+	if v, ok := m["session_name"].(string); ok && v != "" {
+		assumeRole.SessionName = v
+	}
+
+	if v, ok := m["web_identity_token"].(string); ok && v != "" {
+		assumeRole.WebIdentityToken = v
+	}
+
+	if v, ok := m["web_identity_token_file"].(string); ok && v != "" {
+		assumeRole.WebIdentityTokenFile = v
+	}
+
+	return &assumeRole
+}
+
 func expandProviderDefaultTags(l []interface{}) *tftags.DefaultConfig {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -2237,6 +2493,83 @@ func expandProviderIgnoreTags(l []interface{}) *tftags.IgnoreConfig {
 	return ignoreConfig
 }
 
+func expandProviderRequiredTags(tfMap map[string]interface{}) (*tftags.RequiredConfig, error) {
+	requiredConfig := &tftags.RequiredConfig{}
+
+	if v, ok := tfMap["exempt_resource_types"].(*schema.Set); ok && v.Len() > 0 {
+		for _, v := range v.List() {
+			requiredConfig.ExemptResourceTypes = append(requiredConfig.ExemptResourceTypes, v.(string))
+		}
+	}
+
+	seen := make(map[string]bool)
+
+	for _, tfMapRaw := range tfMap["tag"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		requiredTag := &tftags.RequiredTag{
+			Key: tfMap["key"].(string),
+		}
+
+		if seen[requiredTag.Key] {
+			return nil, fmt.Errorf("duplicate required tag (%s)", requiredTag.Key)
+		}
+
+		seen[requiredTag.Key] = true
+
+		for _, v := range tfMap["allowed_values"].([]interface{}) {
+			re, err := tftags.NewAllowedValue(v.(string))
+
+			if err != nil {
+				return nil, fmt.Errorf("invalid allowed value for required tag (%s): %w", requiredTag.Key, err)
+			}
+
+			requiredTag.AllowedValues = append(requiredTag.AllowedValues, re)
+		}
+
+		if v, ok := tfMap["exempt_resource_types"].(*schema.Set); ok && v.Len() > 0 {
+			for _, v := range v.List() {
+				requiredTag.ExemptResourceTypes = append(requiredTag.ExemptResourceTypes, v.(string))
+			}
+		}
+
+		requiredConfig.Tags = append(requiredConfig.Tags, requiredTag)
+	}
+
+	return requiredConfig, nil
+}
+
+func expandConcurrencyLimits(tfList []interface{}) (map[string]int, error) {
+	concurrencyLimits := make(map[string]int)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		hclKey := tfMap["service"].(string)
+		serviceKey, err := conns.ServiceForHCLKey(hclKey)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to assign concurrency limit (%s): %w", hclKey, err)
+		}
+
+		if _, ok := concurrencyLimits[serviceKey]; ok {
+			return nil, fmt.Errorf("duplicate concurrency limit for service (%s)", hclKey)
+		}
+
+		concurrencyLimits[serviceKey] = tfMap["limit"].(int)
+	}
+
+	return concurrencyLimits, nil
+}
+
 func expandEndpoints(endpointsSetList []interface{}, out map[string]string) error {
 	for _, endpointsSetI := range endpointsSetList {
 		endpoints := endpointsSetI.(map[string]interface{})
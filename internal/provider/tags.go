@@ -0,0 +1,26 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// wrapResourceTypeCustomizeDiff wraps the CustomizeDiff function of each resource so that
+// the resource type name is available from its context, e.g. to enforce required_tags in verify.SetTagsDiff.
+func wrapResourceTypeCustomizeDiff(resources map[string]*schema.Resource) {
+	for resourceType, r := range resources {
+		if r.CustomizeDiff == nil {
+			continue
+		}
+
+		r.CustomizeDiff = withResourceType(resourceType, r.CustomizeDiff)
+	}
+}
+
+func withResourceType(resourceType string, f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		return f(conns.NewResourceTypeContext(ctx, resourceType), diff, meta)
+	}
+}
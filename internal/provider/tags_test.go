@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestWrapResourceTypeCustomizeDiff(t *testing.T) {
+	var got string
+
+	resources := map[string]*schema.Resource{
+		"aws_example_thing": {
+			CustomizeDiff: func(ctx context.Context, _ *schema.ResourceDiff, _ interface{}) error {
+				got, _ = conns.ResourceTypeFromContext(ctx)
+
+				return nil
+			},
+		},
+		"aws_example_other": {},
+	}
+
+	wrapResourceTypeCustomizeDiff(resources)
+
+	if resources["aws_example_other"].CustomizeDiff != nil {
+		t.Errorf("unexpected CustomizeDiff for resource without CustomizeDiff")
+	}
+
+	if err := resources["aws_example_thing"].CustomizeDiff(context.Background(), nil, nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := "aws_example_thing"; got != expected {
+		t.Errorf("got resource type %q, expected %q", got, expected)
+	}
+}
+
+func TestExpandProviderRequiredTags(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Config        map[string]interface{}
+		ExpectedTags  int
+		ExpectedError bool
+	}{
+		{
+			Name: "tags",
+			Config: map[string]interface{}{
+				"exempt_resource_types": schema.NewSet(schema.HashString, []interface{}{"aws_iam_*"}),
+				"tag": []interface{}{
+					map[string]interface{}{
+						"key":                   "cost-center",
+						"allowed_values":        []interface{}{`^CC-[0-9]{4}$`},
+						"exempt_resource_types": schema.NewSet(schema.HashString, []interface{}{}),
+					},
+					map[string]interface{}{
+						"key":                   "owner",
+						"allowed_values":        []interface{}{},
+						"exempt_resource_types": schema.NewSet(schema.HashString, []interface{}{"aws_ssm_parameter"}),
+					},
+				},
+			},
+			ExpectedTags: 2,
+		},
+		{
+			Name: "duplicate key",
+			Config: map[string]interface{}{
+				"tag": []interface{}{
+					map[string]interface{}{"key": "owner", "allowed_values": []interface{}{}},
+					map[string]interface{}{"key": "owner", "allowed_values": []interface{}{}},
+				},
+			},
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := expandProviderRequiredTags(testCase.Config)
+
+			if testCase.ExpectedError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(got.Tags) != testCase.ExpectedTags {
+				t.Errorf("got %d tags, expected %d", len(got.Tags), testCase.ExpectedTags)
+			}
+		})
+	}
+}
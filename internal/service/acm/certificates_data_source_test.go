@@ -0,0 +1,73 @@
+package acm_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/acm"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccACMCertificatesDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	key := acctest.TLSRSAPrivateKeyPEM(2048)
+	certificate0 := acctest.TLSRSAX509SelfSignedCertificatePEM(key, fmt.Sprintf("%s-0.example.com", rName))
+	certificate1 := acctest.TLSRSAX509SelfSignedCertificatePEM(key, fmt.Sprintf("%s-1.example.com", rName))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, acm.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCertificatesDataSourceConfig(rName, acctest.TLSPEMEscapeNewlines(key), acctest.TLSPEMEscapeNewlines(certificate0), acctest.TLSPEMEscapeNewlines(certificate1)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_acm_certificates.name_regex", "arns.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_acm_certificates.name_regex", "names.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_acm_certificates.tags", "arns.#", "1"),
+					resource.TestCheckResourceAttrPair("data.aws_acm_certificates.tags", "arns.0", "aws_acm_certificate.test0", "arn"),
+					resource.TestMatchResourceAttr("data.aws_acm_certificates.tags", "names.0", regexp.MustCompile(`-0\.example\.com$`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCertificatesDataSourceConfig(rName, key, certificate0, certificate1 string) string {
+	return fmt.Sprintf(`
+resource "aws_acm_certificate" "test0" {
+  certificate_body = "%[3]s"
+  private_key      = "%[2]s"
+
+  tags = {
+    Name = "%[1]s-0"
+  }
+}
+
+resource "aws_acm_certificate" "test1" {
+  certificate_body = "%[4]s"
+  private_key      = "%[2]s"
+
+  tags = {
+    Name = "%[1]s-1"
+  }
+}
+
+data "aws_acm_certificates" "name_regex" {
+  name_regex = "^%[1]s-"
+
+  depends_on = [aws_acm_certificate.test0, aws_acm_certificate.test1]
+}
+
+data "aws_acm_certificates" "tags" {
+  tags = {
+    Name = "%[1]s-0"
+  }
+
+  depends_on = [aws_acm_certificate.test0, aws_acm_certificate.test1]
+}
+`, rName, key, certificate0, certificate1)
+}
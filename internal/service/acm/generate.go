@@ -1,4 +1,5 @@
 //go:generate go run ../../generate/tags/main.go -ListTags -ListTagsOp=ListTagsForCertificate -ListTagsInIDElem=CertificateArn -ServiceTagsSlice -TagOp=AddTagsToCertificate -TagInIDElem=CertificateArn -UntagOp=RemoveTagsFromCertificate -UntagInNeedTagType -UntagInTagsElem=Tags -UpdateTags
+//go:generate go run -tags generate ../../generate/listdatasource/main.go -Name=Certificates -ListOp=ListCertificates -ListOutElem=CertificateSummaryList -IDElem=CertificateArn -NameElem=DomainName "-HumanFriendly=ACM Certificates"
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package acm
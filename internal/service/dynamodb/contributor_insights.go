@@ -0,0 +1,164 @@
+package dynamodb
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceContributorInsights() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceContributorInsightsCreate,
+		Read:   resourceContributorInsightsRead,
+		Delete: resourceContributorInsightsDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"index_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(3, 255),
+			},
+			"table_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(3, 255),
+			},
+		},
+	}
+}
+
+func resourceContributorInsightsCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+
+	tableName := d.Get("table_name").(string)
+	indexName := d.Get("index_name").(string)
+	input := &dynamodb.UpdateContributorInsightsInput{
+		ContributorInsightsAction: aws.String(dynamodb.ContributorInsightsActionEnable),
+		TableName:                 aws.String(tableName),
+	}
+
+	if indexName != "" {
+		input.IndexName = aws.String(indexName)
+	}
+
+	id := ContributorInsightsCreateResourceID(tableName, indexName)
+
+	log.Printf("[DEBUG] Enabling DynamoDB Contributor Insights: %s", input)
+	_, err := conn.UpdateContributorInsights(input)
+
+	if err != nil {
+		return fmt.Errorf("error enabling DynamoDB Contributor Insights (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitContributorInsightsEnabled(conn, tableName, indexName); err != nil {
+		return fmt.Errorf("error waiting for DynamoDB Contributor Insights (%s) enable: %w", d.Id(), err)
+	}
+
+	return resourceContributorInsightsRead(d, meta)
+}
+
+func resourceContributorInsightsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+
+	tableName, indexName, err := ContributorInsightsParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := FindContributorInsightsByTableNameIndexName(conn, tableName, indexName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DynamoDB Contributor Insights (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading DynamoDB Contributor Insights (%s): %w", d.Id(), err)
+	}
+
+	d.Set("index_name", output.IndexName)
+	d.Set("table_name", output.TableName)
+
+	return nil
+}
+
+func resourceContributorInsightsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+
+	tableName, indexName, err := ContributorInsightsParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &dynamodb.UpdateContributorInsightsInput{
+		ContributorInsightsAction: aws.String(dynamodb.ContributorInsightsActionDisable),
+		TableName:                 aws.String(tableName),
+	}
+
+	if indexName != "" {
+		input.IndexName = aws.String(indexName)
+	}
+
+	log.Printf("[DEBUG] Disabling DynamoDB Contributor Insights: %s", d.Id())
+	_, err = conn.UpdateContributorInsights(input)
+
+	if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error disabling DynamoDB Contributor Insights (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitContributorInsightsDisabled(conn, tableName, indexName); err != nil {
+		return fmt.Errorf("error waiting for DynamoDB Contributor Insights (%s) disable: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+const contributorInsightsResourceIDSeparator = ":"
+
+func ContributorInsightsCreateResourceID(tableName, indexName string) string {
+	if indexName == "" {
+		return tableName
+	}
+
+	parts := []string{tableName, indexName}
+	id := strings.Join(parts, contributorInsightsResourceIDSeparator)
+
+	return id
+}
+
+func ContributorInsightsParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, contributorInsightsResourceIDSeparator)
+
+	if len(parts) == 1 && parts[0] != "" {
+		return parts[0], "", nil
+	}
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected TableName or TableName%[2]sIndexName", id, contributorInsightsResourceIDSeparator)
+}
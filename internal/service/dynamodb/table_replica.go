@@ -0,0 +1,368 @@
+package dynamodb
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceTableReplica() *schema.Resource {
+	//lintignore:R011
+	return &schema.Resource{
+		Create: resourceTableReplicaCreate,
+		Read:   resourceTableReplicaRead,
+		Update: resourceTableReplicaUpdate,
+		Delete: resourceTableReplicaDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"global_table_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"kms_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"point_in_time_recovery": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"table_class_override": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(dynamodb.TableClass_Values(), false),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceTableReplicaCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+	replicaRegion := meta.(*conns.AWSClient).Region
+
+	tableName, mainRegion, err := TableReplicaParseGlobalTableARN(d.Get("global_table_arn").(string))
+
+	if err != nil {
+		return err
+	}
+
+	if mainRegion == replicaRegion {
+		return fmt.Errorf("DynamoDB Table Replica (%s) must be created in a region other than the global table's region (%s)", tableName, mainRegion)
+	}
+
+	mainConn := tableReplicaRegionConn(meta, mainRegion)
+
+	replica := map[string]interface{}{
+		"region_name": replicaRegion,
+	}
+
+	if v, ok := d.GetOk("kms_key_arn"); ok {
+		replica["kms_key_arn"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("table_class_override"); ok {
+		replica["table_class_override"] = v.(string)
+	}
+
+	if err := createDynamoDbReplicas(tableName, []interface{}{replica}, mainConn); err != nil {
+		return err
+	}
+
+	d.SetId(TableReplicaCreateResourceID(tableName, mainRegion))
+
+	if d.Get("point_in_time_recovery").(bool) {
+		if err := updateDynamoDbPITR(conn, tableName, true); err != nil {
+			return fmt.Errorf("error enabling DynamoDB Table Replica (%s) point in time recovery: %w", d.Id(), err)
+		}
+	}
+
+	if len(tags) > 0 {
+		table, err := FindDynamoDBTableByName(conn, tableName)
+
+		if err != nil {
+			return fmt.Errorf("error reading DynamoDB Table Replica (%s): %w", d.Id(), err)
+		}
+
+		if table == nil {
+			return fmt.Errorf("error reading DynamoDB Table Replica (%s): empty output", d.Id())
+		}
+
+		if err := UpdateTags(conn, aws.StringValue(table.TableArn), nil, tags); err != nil {
+			return fmt.Errorf("error adding DynamoDB Table Replica (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceTableReplicaRead(d, meta)
+}
+
+func resourceTableReplicaRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	replicaRegion := meta.(*conns.AWSClient).Region
+
+	tableName, mainRegion, err := TableReplicaParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	mainConn := tableReplicaRegionConn(meta, mainRegion)
+
+	mainTable, err := FindDynamoDBTableByName(mainConn, tableName)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] DynamoDB Table Replica (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading DynamoDB Table (%s) in region (%s): %w", tableName, mainRegion, err)
+	}
+
+	var replica *dynamodb.ReplicaDescription
+
+	if mainTable != nil {
+		for _, v := range mainTable.Replicas {
+			if aws.StringValue(v.RegionName) == replicaRegion {
+				replica = v
+				break
+			}
+		}
+	}
+
+	if !d.IsNewResource() && replica == nil {
+		log.Printf("[WARN] DynamoDB Table Replica (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if replica == nil {
+		return fmt.Errorf("error reading DynamoDB Table Replica (%s): replica not found in global table", d.Id())
+	}
+
+	d.Set("global_table_arn", mainTable.TableArn)
+	d.Set("kms_key_arn", replica.KMSMasterKeyId)
+
+	if replica.ReplicaTableClassSummary != nil {
+		d.Set("table_class_override", replica.ReplicaTableClassSummary.TableClass)
+	} else {
+		d.Set("table_class_override", nil)
+	}
+
+	table, err := FindDynamoDBTableByName(conn, tableName)
+
+	if err != nil {
+		return fmt.Errorf("error reading DynamoDB Table Replica (%s): %w", d.Id(), err)
+	}
+
+	if table == nil {
+		return fmt.Errorf("error reading DynamoDB Table Replica (%s): empty output", d.Id())
+	}
+
+	d.Set("arn", table.TableArn)
+
+	pitr, err := FindDynamoDBPITRDescriptionByTableName(conn, tableName)
+
+	if err != nil && !tfawserr.ErrCodeEquals(err, "UnknownOperationException") {
+		return fmt.Errorf("error describing DynamoDB Table Replica (%s) Continuous Backups: %w", d.Id(), err)
+	}
+
+	d.Set("point_in_time_recovery", pitr != nil && aws.StringValue(pitr.PointInTimeRecoveryStatus) == dynamodb.PointInTimeRecoveryStatusEnabled)
+
+	tags, err := ListTags(conn, aws.StringValue(table.TableArn))
+
+	if err != nil && !tfawserr.ErrMessageContains(err, "UnknownOperationException", "Tagging is not currently supported in DynamoDB Local.") {
+		return fmt.Errorf("error listing tags for DynamoDB Table Replica (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceTableReplicaUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+	replicaRegion := meta.(*conns.AWSClient).Region
+
+	tableName, mainRegion, err := TableReplicaParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("table_class_override") {
+		mainConn := tableReplicaRegionConn(meta, mainRegion)
+
+		input := &dynamodb.UpdateTableInput{
+			TableName: aws.String(tableName),
+			ReplicaUpdates: []*dynamodb.ReplicationGroupUpdate{
+				{
+					Update: &dynamodb.UpdateReplicationGroupMemberAction{
+						RegionName:         aws.String(replicaRegion),
+						TableClassOverride: aws.String(d.Get("table_class_override").(string)),
+					},
+				},
+			},
+		}
+
+		err = resource.Retry(replicaUpdateTimeout, func() *resource.RetryError {
+			_, err := mainConn.UpdateTable(input)
+
+			if tfawserr.ErrCodeEquals(err, "ThrottlingException") || tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceInUseException) {
+				return resource.RetryableError(err)
+			}
+
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+
+			return nil
+		})
+
+		if tfresource.TimedOut(err) {
+			_, err = mainConn.UpdateTable(input)
+		}
+
+		if err != nil {
+			return fmt.Errorf("error updating DynamoDB Table Replica (%s) table class: %w", d.Id(), err)
+		}
+
+		if _, err := waitDynamoDBReplicaActive(mainConn, tableName, replicaRegion); err != nil {
+			return fmt.Errorf("error waiting for DynamoDB Table Replica (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("point_in_time_recovery") {
+		if err := updateDynamoDbPITR(conn, tableName, d.Get("point_in_time_recovery").(bool)); err != nil {
+			return fmt.Errorf("error updating DynamoDB Table Replica (%s) point in time recovery: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating DynamoDB Table Replica (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceTableReplicaRead(d, meta)
+}
+
+func resourceTableReplicaDelete(d *schema.ResourceData, meta interface{}) error {
+	replicaRegion := meta.(*conns.AWSClient).Region
+
+	tableName, mainRegion, err := TableReplicaParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	mainConn := tableReplicaRegionConn(meta, mainRegion)
+
+	log.Printf("[DEBUG] Deleting DynamoDB Table Replica: %s", d.Id())
+	err = deleteDynamoDbReplicas(tableName, []interface{}{map[string]interface{}{"region_name": replicaRegion}}, mainConn)
+
+	if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting DynamoDB Table Replica (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// tableReplicaRegionConn returns a DynamoDB client for the specified region created from the
+// provider's session, so that the provider's credentials and request handlers are re-used.
+// Any custom DynamoDB endpoint is region-specific and is not applied.
+func tableReplicaRegionConn(meta interface{}, region string) *dynamodb.DynamoDB {
+	client := meta.(*conns.AWSClient)
+
+	if client.Region == region {
+		return client.DynamoDBConn
+	}
+
+	return dynamodb.New(client.Session, &aws.Config{Region: aws.String(region)})
+}
+
+const tableReplicaResourceIDSeparator = ":"
+
+func TableReplicaCreateResourceID(tableName, mainRegion string) string {
+	parts := []string{tableName, mainRegion}
+	id := strings.Join(parts, tableReplicaResourceIDSeparator)
+
+	return id
+}
+
+func TableReplicaParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, tableReplicaResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected TableName%[2]sMainRegion", id, tableReplicaResourceIDSeparator)
+}
+
+// TableReplicaParseGlobalTableARN returns the table name and region from a DynamoDB table ARN.
+func TableReplicaParseGlobalTableARN(v string) (string, string, error) {
+	tableARN, err := arn.Parse(v)
+
+	if err != nil {
+		return "", "", fmt.Errorf("error parsing DynamoDB Table ARN (%s): %w", v, err)
+	}
+
+	tableName := strings.TrimPrefix(tableARN.Resource, "table/")
+
+	if tableName == tableARN.Resource || tableName == "" || strings.Contains(tableName, "/") {
+		return "", "", fmt.Errorf("unexpected format for DynamoDB Table ARN (%s), expected arn:PARTITION:dynamodb:REGION:ACCOUNT:table/NAME", v)
+	}
+
+	return tableName, tableARN.Region, nil
+}
@@ -0,0 +1,190 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceInstanceState() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceInstanceStateCreate,
+		ReadWithoutTimeout:   resourceInstanceStateRead,
+		UpdateWithoutTimeout: resourceInstanceStateUpdate,
+		DeleteWithoutTimeout: resourceInstanceStateDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"force": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.InstanceStateNameRunning,
+					ec2.InstanceStateNameStopped,
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceInstanceStateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	instanceID := d.Get("instance_id").(string)
+	instance, err := FindInstanceByID(conn, instanceID)
+
+	if err != nil {
+		return diag.Errorf("error reading EC2 Instance (%s): %s", instanceID, err)
+	}
+
+	if err := updateInstanceState(ctx, conn, instanceID, aws.StringValue(instance.State.Name), d.Get("state").(string), d.Get("force").(bool), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(instanceID)
+
+	return resourceInstanceStateRead(ctx, d, meta)
+}
+
+func resourceInstanceStateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	instance, err := FindInstanceByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Instance State (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading EC2 Instance State (%s): %s", d.Id(), err)
+	}
+
+	d.Set("instance_id", instance.InstanceId)
+	d.Set("state", instanceStateSettled(aws.StringValue(instance.State.Name)))
+
+	return nil
+}
+
+func resourceInstanceStateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	if d.HasChange("state") {
+		instance, err := FindInstanceByID(conn, d.Id())
+
+		if err != nil {
+			return diag.Errorf("error reading EC2 Instance (%s): %s", d.Id(), err)
+		}
+
+		if err := updateInstanceState(ctx, conn, d.Id(), aws.StringValue(instance.State.Name), d.Get("state").(string), d.Get("force").(bool), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceInstanceStateRead(ctx, d, meta)
+}
+
+func resourceInstanceStateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Removing the resource leaves the instance in its current state.
+	log.Printf("[DEBUG] Removing EC2 Instance State (%s) from state", d.Id())
+
+	return nil
+}
+
+// instanceStateSettled returns the state that an instance in a transitional state is heading to,
+// so that an instance that is still starting or stopping does not show a difference.
+func instanceStateSettled(state string) string {
+	switch state {
+	case ec2.InstanceStateNamePending:
+		return ec2.InstanceStateNameRunning
+	case ec2.InstanceStateNameStopping:
+		return ec2.InstanceStateNameStopped
+	default:
+		return state
+	}
+}
+
+// updateInstanceState starts or stops an EC2 instance so that it reaches the
+// desired state, first letting any in-progress transition settle.
+func updateInstanceState(ctx context.Context, conn *ec2.EC2, id string, currentState string, desiredState string, force bool, timeout time.Duration) error {
+	switch currentState {
+	case ec2.InstanceStateNameShuttingDown, ec2.InstanceStateNameTerminated:
+		return fmt.Errorf("EC2 Instance (%s) is %s, its state cannot be changed to %s", id, currentState, desiredState)
+	case ec2.InstanceStateNamePending:
+		if err := WaitForInstanceRunning(conn, id, timeout); err != nil {
+			return err
+		}
+
+		currentState = ec2.InstanceStateNameRunning
+	case ec2.InstanceStateNameStopping:
+		if err := WaitForInstanceStopping(conn, id, timeout); err != nil {
+			return err
+		}
+
+		currentState = ec2.InstanceStateNameStopped
+	}
+
+	if currentState == desiredState {
+		return nil
+	}
+
+	switch desiredState {
+	case ec2.InstanceStateNameStopped:
+		log.Printf("[INFO] Stopping EC2 Instance: %s", id)
+		_, err := conn.StopInstancesWithContext(ctx, &ec2.StopInstancesInput{
+			Force:       aws.Bool(force),
+			InstanceIds: aws.StringSlice([]string{id}),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error stopping EC2 Instance (%s): %w", id, err)
+		}
+
+		if err := WaitForInstanceStopping(conn, id, timeout); err != nil {
+			return err
+		}
+	case ec2.InstanceStateNameRunning:
+		log.Printf("[INFO] Starting EC2 Instance: %s", id)
+		_, err := conn.StartInstancesWithContext(ctx, &ec2.StartInstancesInput{
+			InstanceIds: aws.StringSlice([]string{id}),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error starting EC2 Instance (%s): %w", id, err)
+		}
+
+		if err := WaitForInstanceRunning(conn, id, timeout); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
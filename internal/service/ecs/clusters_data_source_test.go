@@ -0,0 +1,61 @@
+package ecs_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ecs"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccECSClustersDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, ecs.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClustersDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_ecs_clusters.name_regex", "arns.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_ecs_clusters.name_regex", "names.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_ecs_clusters.tags", "arns.#", "1"),
+					resource.TestCheckResourceAttrPair("data.aws_ecs_clusters.tags", "arns.0", "aws_ecs_cluster.test.0", "arn"),
+					resource.TestCheckResourceAttrPair("data.aws_ecs_clusters.tags", "names.0", "aws_ecs_cluster.test.0", "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccClustersDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_cluster" "test" {
+  count = 2
+
+  name = "%[1]s-${count.index}"
+
+  tags = {
+    Name = "%[1]s-${count.index}"
+  }
+}
+
+data "aws_ecs_clusters" "name_regex" {
+  name_regex = "^%[1]s-"
+
+  depends_on = [aws_ecs_cluster.test]
+}
+
+data "aws_ecs_clusters" "tags" {
+  tags = {
+    Name = "%[1]s-0"
+  }
+
+  depends_on = [aws_ecs_cluster.test]
+}
+`, rName)
+}
@@ -1,6 +1,7 @@
 //go:generate go run ../../generate/listpages/main.go -ListOps=DescribeCapacityProviders
 //go:generate go run ../../generate/tagresource/main.go
 //go:generate go run ../../generate/tags/main.go -GetTag -ListTags -ServiceTagsSlice -UpdateTags -ParentNotFoundErrCode=InvalidParameterException "-ParentNotFoundErrMsg=The specified cluster is inactive. Specify an active cluster and try again."
+//go:generate go run -tags generate ../../generate/listdatasource/main.go -Name=Clusters -ListOp=ListClusters -ListOutElem=ClusterArns "-HumanFriendly=ECS Clusters"
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package ecs
@@ -1,4 +1,5 @@
 //go:generate go run ../../generate/tags/main.go -ListTags -ListTagsOp=DescribeTags -ListTagsInIDElem=ResourceArns -ListTagsInIDNeedSlice=yes -ListTagsOutTagsElem=TagDescriptions[0].Tags -ServiceTagsSlice -TagOp=AddTags -TagInIDElem=ResourceArns -TagInIDNeedSlice=yes -UntagOp=RemoveTags -UpdateTags
+//go:generate go run -tags generate ../../generate/listdatasource/main.go -Name=TargetGroups -ListOp=DescribeTargetGroups -ListOutElem=TargetGroups -IDElem=TargetGroupArn -NameElem=TargetGroupName "-HumanFriendly=ELBv2 Target Groups"
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package elbv2
@@ -0,0 +1,114 @@
+// Code generated by "internal/generate/listdatasource/main.go -Name=TargetGroups -ListOp=DescribeTargetGroups -ListOutElem=TargetGroups -IDElem=TargetGroupArn -NameElem=TargetGroupName -HumanFriendly=ELBv2 Target Groups"; DO NOT EDIT.
+
+package elbv2
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func DataSourceTargetGroups() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTargetGroupsRead,
+
+		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": tftags.TagsSchema(),
+		},
+	}
+}
+
+func dataSourceTargetGroupsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ELBV2Conn
+
+	var nameRegex *regexp.Regexp
+
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+
+	tagsToMatch := tftags.New(d.Get("tags").(map[string]interface{})).IgnoreAWS()
+
+	input := &elbv2.DescribeTargetGroupsInput{}
+
+	var ids, names []string
+	var tagsErr error
+
+	err := conn.DescribeTargetGroupsPages(input, func(page *elbv2.DescribeTargetGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.TargetGroups {
+			if v == nil {
+				continue
+			}
+
+			id := aws.StringValue(v.TargetGroupArn)
+			name := aws.StringValue(v.TargetGroupName)
+
+			if nameRegex != nil && !nameRegex.MatchString(name) {
+				continue
+			}
+
+			if len(tagsToMatch) > 0 {
+				tags, err := ListTags(conn, id)
+
+				if err != nil {
+					tagsErr = fmt.Errorf("error listing tags for %s: %w", id, err)
+					return false
+				}
+
+				if !tags.ContainsAll(tagsToMatch) {
+					continue
+				}
+			}
+
+			ids = append(ids, id)
+			names = append(names, name)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading ELBv2 Target Groups: %w", err)
+	}
+
+	if tagsErr != nil {
+		return tagsErr
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+
+	if err := d.Set("arns", ids); err != nil {
+		return fmt.Errorf("error setting arns: %w", err)
+	}
+
+	if err := d.Set("names", names); err != nil {
+		return fmt.Errorf("error setting names: %w", err)
+	}
+
+	return nil
+}
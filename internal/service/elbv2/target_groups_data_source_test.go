@@ -0,0 +1,62 @@
+package elbv2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccELBV2TargetGroupsDataSource_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, elbv2.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTargetGroupsDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_lb_target_groups.name_regex", "arns.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_lb_target_groups.name_regex", "names.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_lb_target_groups.tags", "arns.#", "1"),
+					resource.TestCheckResourceAttrPair("data.aws_lb_target_groups.tags", "arns.0", "aws_lb_target_group.test.0", "arn"),
+					resource.TestCheckResourceAttrPair("data.aws_lb_target_groups.tags", "names.0", "aws_lb_target_group.test.0", "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTargetGroupsDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_lb_target_group" "test" {
+  count = 2
+
+  name        = "%[1]s-${count.index}"
+  target_type = "lambda"
+
+  tags = {
+    Name = "%[1]s-${count.index}"
+  }
+}
+
+data "aws_lb_target_groups" "name_regex" {
+  name_regex = "^%[1]s-"
+
+  depends_on = [aws_lb_target_group.test]
+}
+
+data "aws_lb_target_groups" "tags" {
+  tags = {
+    Name = "%[1]s-0"
+  }
+
+  depends_on = [aws_lb_target_group.test]
+}
+`, rName)
+}
@@ -0,0 +1,1560 @@
+package iam
+
+import (
+	"strings"
+)
+
+// policyCatalogService describes the IAM actions and condition keys of an AWS service,
+// as listed in the Service Authorization Reference
+// (https://docs.aws.amazon.com/service-authorization/latest/reference/reference_policies_actions-resources-contextkeys.html).
+type policyCatalogService struct {
+	// Actions are the names of the service's actions, without the service prefix.
+	Actions []string
+
+	// ConditionKeys are the service-specific condition keys, with the service prefix.
+	// Keys ending in "/*" or ":*" are key prefixes, e.g. "s3:ExistingObjectTag/*".
+	// Condition keys are not checked for services without condition keys, e.g. "ec2".
+	ConditionKeys []string
+
+	// WildcardOnlyActions are the write actions that do not support resource-level permissions
+	// and must be allowed on all resources ("*").
+	// Write actions of services without wildcard-only actions, e.g. "ec2", are all assumed to
+	// support resource-level permissions.
+	WildcardOnlyActions []string
+}
+
+// policyCatalog is the bundled catalog of IAM actions and condition keys, by service prefix.
+// Only actions of the services in the catalog are checked, and the catalog may lag behind
+// newly released actions, so actions missing from the catalog are reported as warnings.
+var policyCatalog = map[string]policyCatalogService{
+	"dynamodb": {
+		Actions: []string{
+			"BatchGetItem",
+			"BatchWriteItem",
+			"ConditionCheckItem",
+			"CreateBackup",
+			"CreateGlobalTable",
+			"CreateTable",
+			"CreateTableReplica",
+			"DeleteBackup",
+			"DeleteItem",
+			"DeleteTable",
+			"DeleteTableReplica",
+			"DescribeBackup",
+			"DescribeContinuousBackups",
+			"DescribeContributorInsights",
+			"DescribeEndpoints",
+			"DescribeExport",
+			"DescribeGlobalTable",
+			"DescribeGlobalTableSettings",
+			"DescribeImport",
+			"DescribeKinesisStreamingDestination",
+			"DescribeLimits",
+			"DescribeReservedCapacity",
+			"DescribeReservedCapacityOfferings",
+			"DescribeStream",
+			"DescribeTable",
+			"DescribeTableReplicaAutoScaling",
+			"DescribeTimeToLive",
+			"DisableKinesisStreamingDestination",
+			"EnableKinesisStreamingDestination",
+			"ExportTableToPointInTime",
+			"GetItem",
+			"GetRecords",
+			"GetShardIterator",
+			"ImportTable",
+			"ListBackups",
+			"ListContributorInsights",
+			"ListExports",
+			"ListGlobalTables",
+			"ListImports",
+			"ListStreams",
+			"ListTables",
+			"ListTagsOfResource",
+			"PartiQLDelete",
+			"PartiQLInsert",
+			"PartiQLSelect",
+			"PartiQLUpdate",
+			"PurchaseReservedCapacityOfferings",
+			"PutItem",
+			"Query",
+			"RestoreTableFromAwsBackup",
+			"RestoreTableFromBackup",
+			"RestoreTableToPointInTime",
+			"Scan",
+			"StartAwsBackupJob",
+			"TagResource",
+			"UntagResource",
+			"UpdateContinuousBackups",
+			"UpdateContributorInsights",
+			"UpdateGlobalTable",
+			"UpdateGlobalTableSettings",
+			"UpdateGlobalTableVersion",
+			"UpdateItem",
+			"UpdateTable",
+			"UpdateTableReplicaAutoScaling",
+			"UpdateTimeToLive",
+		},
+		ConditionKeys: []string{
+			"dynamodb:Attributes",
+			"dynamodb:EnclosingOperation",
+			"dynamodb:FullTableScan",
+			"dynamodb:LeadingKeys",
+			"dynamodb:ReturnConsumedCapacity",
+			"dynamodb:ReturnValues",
+			"dynamodb:Select",
+		},
+		WildcardOnlyActions: []string{
+			"PurchaseReservedCapacityOfferings",
+		},
+	},
+	"ec2": {
+		Actions: []string{
+			"AcceptReservedInstancesExchangeQuote",
+			"AcceptTransitGatewayMulticastDomainAssociations",
+			"AcceptTransitGatewayPeeringAttachment",
+			"AcceptTransitGatewayVpcAttachment",
+			"AcceptVpcEndpointConnections",
+			"AcceptVpcPeeringConnection",
+			"AdvertiseByoipCidr",
+			"AllocateAddress",
+			"AllocateHosts",
+			"AllocateIpamPoolCidr",
+			"ApplySecurityGroupsToClientVpnTargetNetwork",
+			"AssignIpv6Addresses",
+			"AssignPrivateIpAddresses",
+			"AssociateAddress",
+			"AssociateClientVpnTargetNetwork",
+			"AssociateDhcpOptions",
+			"AssociateEnclaveCertificateIamRole",
+			"AssociateIamInstanceProfile",
+			"AssociateInstanceEventWindow",
+			"AssociateRouteTable",
+			"AssociateSubnetCidrBlock",
+			"AssociateTransitGatewayMulticastDomain",
+			"AssociateTransitGatewayRouteTable",
+			"AssociateTrunkInterface",
+			"AssociateVpcCidrBlock",
+			"AttachClassicLinkVpc",
+			"AttachInternetGateway",
+			"AttachNetworkInterface",
+			"AttachVolume",
+			"AttachVpnGateway",
+			"AuthorizeClientVpnIngress",
+			"AuthorizeSecurityGroupEgress",
+			"AuthorizeSecurityGroupIngress",
+			"BundleInstance",
+			"CancelBundleTask",
+			"CancelCapacityReservation",
+			"CancelCapacityReservationFleets",
+			"CancelConversionTask",
+			"CancelExportTask",
+			"CancelImportTask",
+			"CancelReservedInstancesListing",
+			"CancelSpotFleetRequests",
+			"CancelSpotInstanceRequests",
+			"ConfirmProductInstance",
+			"CopyFpgaImage",
+			"CopyImage",
+			"CopySnapshot",
+			"CreateCapacityReservation",
+			"CreateCapacityReservationFleet",
+			"CreateCarrierGateway",
+			"CreateClientVpnEndpoint",
+			"CreateClientVpnRoute",
+			"CreateCustomerGateway",
+			"CreateDefaultSubnet",
+			"CreateDefaultVpc",
+			"CreateDhcpOptions",
+			"CreateEgressOnlyInternetGateway",
+			"CreateFleet",
+			"CreateFlowLogs",
+			"CreateFpgaImage",
+			"CreateImage",
+			"CreateInstanceEventWindow",
+			"CreateInstanceExportTask",
+			"CreateInternetGateway",
+			"CreateIpam",
+			"CreateIpamPool",
+			"CreateIpamScope",
+			"CreateKeyPair",
+			"CreateLaunchTemplate",
+			"CreateLaunchTemplateVersion",
+			"CreateLocalGatewayRoute",
+			"CreateLocalGatewayRouteTableVpcAssociation",
+			"CreateManagedPrefixList",
+			"CreateNatGateway",
+			"CreateNetworkAcl",
+			"CreateNetworkAclEntry",
+			"CreateNetworkInsightsAccessScope",
+			"CreateNetworkInsightsPath",
+			"CreateNetworkInterface",
+			"CreateNetworkInterfacePermission",
+			"CreatePlacementGroup",
+			"CreatePublicIpv4Pool",
+			"CreateReplaceRootVolumeTask",
+			"CreateReservedInstancesListing",
+			"CreateRestoreImageTask",
+			"CreateRoute",
+			"CreateRouteTable",
+			"CreateSecurityGroup",
+			"CreateSnapshot",
+			"CreateSnapshots",
+			"CreateSpotDatafeedSubscription",
+			"CreateStoreImageTask",
+			"CreateSubnet",
+			"CreateSubnetCidrReservation",
+			"CreateTags",
+			"CreateTrafficMirrorFilter",
+			"CreateTrafficMirrorFilterRule",
+			"CreateTrafficMirrorSession",
+			"CreateTrafficMirrorTarget",
+			"CreateTransitGateway",
+			"CreateTransitGatewayConnect",
+			"CreateTransitGatewayConnectPeer",
+			"CreateTransitGatewayMulticastDomain",
+			"CreateTransitGatewayPeeringAttachment",
+			"CreateTransitGatewayPrefixListReference",
+			"CreateTransitGatewayRoute",
+			"CreateTransitGatewayRouteTable",
+			"CreateTransitGatewayVpcAttachment",
+			"CreateVolume",
+			"CreateVpc",
+			"CreateVpcEndpoint",
+			"CreateVpcEndpointConnectionNotification",
+			"CreateVpcEndpointServiceConfiguration",
+			"CreateVpcPeeringConnection",
+			"CreateVpnConnection",
+			"CreateVpnConnectionRoute",
+			"CreateVpnGateway",
+			"DeleteCarrierGateway",
+			"DeleteClientVpnEndpoint",
+			"DeleteClientVpnRoute",
+			"DeleteCustomerGateway",
+			"DeleteDhcpOptions",
+			"DeleteEgressOnlyInternetGateway",
+			"DeleteFleets",
+			"DeleteFlowLogs",
+			"DeleteFpgaImage",
+			"DeleteInstanceEventWindow",
+			"DeleteInternetGateway",
+			"DeleteIpam",
+			"DeleteIpamPool",
+			"DeleteIpamScope",
+			"DeleteKeyPair",
+			"DeleteLaunchTemplate",
+			"DeleteLaunchTemplateVersions",
+			"DeleteLocalGatewayRoute",
+			"DeleteLocalGatewayRouteTableVpcAssociation",
+			"DeleteManagedPrefixList",
+			"DeleteNatGateway",
+			"DeleteNetworkAcl",
+			"DeleteNetworkAclEntry",
+			"DeleteNetworkInsightsAccessScope",
+			"DeleteNetworkInsightsAccessScopeAnalysis",
+			"DeleteNetworkInsightsAnalysis",
+			"DeleteNetworkInsightsPath",
+			"DeleteNetworkInterface",
+			"DeleteNetworkInterfacePermission",
+			"DeletePlacementGroup",
+			"DeletePublicIpv4Pool",
+			"DeleteQueuedReservedInstances",
+			"DeleteRoute",
+			"DeleteRouteTable",
+			"DeleteSecurityGroup",
+			"DeleteSnapshot",
+			"DeleteSpotDatafeedSubscription",
+			"DeleteSubnet",
+			"DeleteSubnetCidrReservation",
+			"DeleteTags",
+			"DeleteTrafficMirrorFilter",
+			"DeleteTrafficMirrorFilterRule",
+			"DeleteTrafficMirrorSession",
+			"DeleteTrafficMirrorTarget",
+			"DeleteTransitGateway",
+			"DeleteTransitGatewayConnect",
+			"DeleteTransitGatewayConnectPeer",
+			"DeleteTransitGatewayMulticastDomain",
+			"DeleteTransitGatewayPeeringAttachment",
+			"DeleteTransitGatewayPrefixListReference",
+			"DeleteTransitGatewayRoute",
+			"DeleteTransitGatewayRouteTable",
+			"DeleteTransitGatewayVpcAttachment",
+			"DeleteVolume",
+			"DeleteVpc",
+			"DeleteVpcEndpointConnectionNotifications",
+			"DeleteVpcEndpointServiceConfigurations",
+			"DeleteVpcEndpoints",
+			"DeleteVpcPeeringConnection",
+			"DeleteVpnConnection",
+			"DeleteVpnConnectionRoute",
+			"DeleteVpnGateway",
+			"DeprovisionByoipCidr",
+			"DeprovisionIpamPoolCidr",
+			"DeprovisionPublicIpv4PoolCidr",
+			"DeregisterImage",
+			"DeregisterInstanceEventNotificationAttributes",
+			"DeregisterTransitGatewayMulticastGroupMembers",
+			"DeregisterTransitGatewayMulticastGroupSources",
+			"DescribeAccountAttributes",
+			"DescribeAddresses",
+			"DescribeAddressesAttribute",
+			"DescribeAggregateIdFormat",
+			"DescribeAvailabilityZones",
+			"DescribeBundleTasks",
+			"DescribeByoipCidrs",
+			"DescribeCapacityReservationFleets",
+			"DescribeCapacityReservations",
+			"DescribeCarrierGateways",
+			"DescribeClassicLinkInstances",
+			"DescribeClientVpnAuthorizationRules",
+			"DescribeClientVpnConnections",
+			"DescribeClientVpnEndpoints",
+			"DescribeClientVpnRoutes",
+			"DescribeClientVpnTargetNetworks",
+			"DescribeCoipPools",
+			"DescribeConversionTasks",
+			"DescribeCustomerGateways",
+			"DescribeDhcpOptions",
+			"DescribeEgressOnlyInternetGateways",
+			"DescribeElasticGpus",
+			"DescribeExportImageTasks",
+			"DescribeExportTasks",
+			"DescribeFastLaunchImages",
+			"DescribeFastSnapshotRestores",
+			"DescribeFleetHistory",
+			"DescribeFleetInstances",
+			"DescribeFleets",
+			"DescribeFlowLogs",
+			"DescribeFpgaImageAttribute",
+			"DescribeFpgaImages",
+			"DescribeHostReservationOfferings",
+			"DescribeHostReservations",
+			"DescribeHosts",
+			"DescribeIamInstanceProfileAssociations",
+			"DescribeIdFormat",
+			"DescribeIdentityIdFormat",
+			"DescribeImageAttribute",
+			"DescribeImages",
+			"DescribeImportImageTasks",
+			"DescribeImportSnapshotTasks",
+			"DescribeInstanceAttribute",
+			"DescribeInstanceCreditSpecifications",
+			"DescribeInstanceEventNotificationAttributes",
+			"DescribeInstanceEventWindows",
+			"DescribeInstanceStatus",
+			"DescribeInstanceTypeOfferings",
+			"DescribeInstanceTypes",
+			"DescribeInstances",
+			"DescribeInternetGateways",
+			"DescribeIpamPools",
+			"DescribeIpamScopes",
+			"DescribeIpams",
+			"DescribeIpv6Pools",
+			"DescribeKeyPairs",
+			"DescribeLaunchTemplateVersions",
+			"DescribeLaunchTemplates",
+			"DescribeLocalGatewayRouteTableVirtualInterfaceGroupAssociations",
+			"DescribeLocalGatewayRouteTableVpcAssociations",
+			"DescribeLocalGatewayRouteTables",
+			"DescribeLocalGatewayVirtualInterfaceGroups",
+			"DescribeLocalGatewayVirtualInterfaces",
+			"DescribeLocalGateways",
+			"DescribeManagedPrefixLists",
+			"DescribeMovingAddresses",
+			"DescribeNatGateways",
+			"DescribeNetworkAcls",
+			"DescribeNetworkInsightsAccessScopeAnalyses",
+			"DescribeNetworkInsightsAccessScopes",
+			"DescribeNetworkInsightsAnalyses",
+			"DescribeNetworkInsightsPaths",
+			"DescribeNetworkInterfaceAttribute",
+			"DescribeNetworkInterfacePermissions",
+			"DescribeNetworkInterfaces",
+			"DescribePlacementGroups",
+			"DescribePrefixLists",
+			"DescribePrincipalIdFormat",
+			"DescribePublicIpv4Pools",
+			"DescribeRegions",
+			"DescribeReplaceRootVolumeTasks",
+			"DescribeReservedInstances",
+			"DescribeReservedInstancesListings",
+			"DescribeReservedInstancesModifications",
+			"DescribeReservedInstancesOfferings",
+			"DescribeRouteTables",
+			"DescribeScheduledInstanceAvailability",
+			"DescribeScheduledInstances",
+			"DescribeSecurityGroupReferences",
+			"DescribeSecurityGroupRules",
+			"DescribeSecurityGroups",
+			"DescribeSnapshotAttribute",
+			"DescribeSnapshotTierStatus",
+			"DescribeSnapshots",
+			"DescribeSpotDatafeedSubscription",
+			"DescribeSpotFleetInstances",
+			"DescribeSpotFleetRequestHistory",
+			"DescribeSpotFleetRequests",
+			"DescribeSpotInstanceRequests",
+			"DescribeSpotPriceHistory",
+			"DescribeStaleSecurityGroups",
+			"DescribeStoreImageTasks",
+			"DescribeSubnets",
+			"DescribeTags",
+			"DescribeTrafficMirrorFilters",
+			"DescribeTrafficMirrorSessions",
+			"DescribeTrafficMirrorTargets",
+			"DescribeTransitGatewayAttachments",
+			"DescribeTransitGatewayConnectPeers",
+			"DescribeTransitGatewayConnects",
+			"DescribeTransitGatewayMulticastDomains",
+			"DescribeTransitGatewayPeeringAttachments",
+			"DescribeTransitGatewayRouteTables",
+			"DescribeTransitGatewayVpcAttachments",
+			"DescribeTransitGateways",
+			"DescribeTrunkInterfaceAssociations",
+			"DescribeVolumeAttribute",
+			"DescribeVolumeStatus",
+			"DescribeVolumes",
+			"DescribeVolumesModifications",
+			"DescribeVpcAttribute",
+			"DescribeVpcClassicLink",
+			"DescribeVpcClassicLinkDnsSupport",
+			"DescribeVpcEndpointConnectionNotifications",
+			"DescribeVpcEndpointConnections",
+			"DescribeVpcEndpointServiceConfigurations",
+			"DescribeVpcEndpointServicePermissions",
+			"DescribeVpcEndpointServices",
+			"DescribeVpcEndpoints",
+			"DescribeVpcPeeringConnections",
+			"DescribeVpcs",
+			"DescribeVpnConnections",
+			"DescribeVpnGateways",
+			"DetachClassicLinkVpc",
+			"DetachInternetGateway",
+			"DetachNetworkInterface",
+			"DetachVolume",
+			"DetachVpnGateway",
+			"DisableEbsEncryptionByDefault",
+			"DisableFastLaunch",
+			"DisableFastSnapshotRestores",
+			"DisableImageDeprecation",
+			"DisableIpamOrganizationAdminAccount",
+			"DisableSerialConsoleAccess",
+			"DisableTransitGatewayRouteTablePropagation",
+			"DisableVgwRoutePropagation",
+			"DisableVpcClassicLink",
+			"DisableVpcClassicLinkDnsSupport",
+			"DisassociateAddress",
+			"DisassociateClientVpnTargetNetwork",
+			"DisassociateEnclaveCertificateIamRole",
+			"DisassociateIamInstanceProfile",
+			"DisassociateInstanceEventWindow",
+			"DisassociateRouteTable",
+			"DisassociateSubnetCidrBlock",
+			"DisassociateTransitGatewayMulticastDomain",
+			"DisassociateTransitGatewayRouteTable",
+			"DisassociateTrunkInterface",
+			"DisassociateVpcCidrBlock",
+			"EnableEbsEncryptionByDefault",
+			"EnableFastLaunch",
+			"EnableFastSnapshotRestores",
+			"EnableImageDeprecation",
+			"EnableIpamOrganizationAdminAccount",
+			"EnableSerialConsoleAccess",
+			"EnableTransitGatewayRouteTablePropagation",
+			"EnableVgwRoutePropagation",
+			"EnableVolumeIO",
+			"EnableVpcClassicLink",
+			"EnableVpcClassicLinkDnsSupport",
+			"ExportClientVpnClientCertificateRevocationList",
+			"ExportClientVpnClientConfiguration",
+			"ExportImage",
+			"ExportTransitGatewayRoutes",
+			"GetAssociatedEnclaveCertificateIamRoles",
+			"GetAssociatedIpv6PoolCidrs",
+			"GetCapacityReservationUsage",
+			"GetCoipPoolUsage",
+			"GetConsoleOutput",
+			"GetConsoleScreenshot",
+			"GetDefaultCreditSpecification",
+			"GetEbsDefaultKmsKeyId",
+			"GetEbsEncryptionByDefault",
+			"GetFlowLogsIntegrationTemplate",
+			"GetGroupsForCapacityReservation",
+			"GetHostReservationPurchasePreview",
+			"GetInstanceTypesFromInstanceRequirements",
+			"GetIpamAddressHistory",
+			"GetIpamPoolAllocations",
+			"GetIpamPoolCidrs",
+			"GetIpamResourceCidrs",
+			"GetLaunchTemplateData",
+			"GetManagedPrefixListAssociations",
+			"GetManagedPrefixListEntries",
+			"GetNetworkInsightsAccessScopeAnalysisFindings",
+			"GetNetworkInsightsAccessScopeContent",
+			"GetPasswordData",
+			"GetReservedInstancesExchangeQuote",
+			"GetSerialConsoleAccessStatus",
+			"GetSpotPlacementScores",
+			"GetSubnetCidrReservations",
+			"GetTransitGatewayAttachmentPropagations",
+			"GetTransitGatewayMulticastDomainAssociations",
+			"GetTransitGatewayPrefixListReferences",
+			"GetTransitGatewayRouteTableAssociations",
+			"GetTransitGatewayRouteTablePropagations",
+			"GetVpnConnectionDeviceSampleConfiguration",
+			"GetVpnConnectionDeviceTypes",
+			"ImportClientVpnClientCertificateRevocationList",
+			"ImportImage",
+			"ImportInstance",
+			"ImportKeyPair",
+			"ImportSnapshot",
+			"ImportVolume",
+			"ListImagesInRecycleBin",
+			"ListSnapshotsInRecycleBin",
+			"ModifyAddressAttribute",
+			"ModifyAvailabilityZoneGroup",
+			"ModifyCapacityReservation",
+			"ModifyCapacityReservationFleet",
+			"ModifyClientVpnEndpoint",
+			"ModifyDefaultCreditSpecification",
+			"ModifyEbsDefaultKmsKeyId",
+			"ModifyFleet",
+			"ModifyFpgaImageAttribute",
+			"ModifyHosts",
+			"ModifyIdFormat",
+			"ModifyIdentityIdFormat",
+			"ModifyImageAttribute",
+			"ModifyInstanceAttribute",
+			"ModifyInstanceCapacityReservationAttributes",
+			"ModifyInstanceCreditSpecification",
+			"ModifyInstanceEventStartTime",
+			"ModifyInstanceEventWindow",
+			"ModifyInstanceMetadataOptions",
+			"ModifyInstancePlacement",
+			"ModifyIpam",
+			"ModifyIpamPool",
+			"ModifyIpamResourceCidr",
+			"ModifyIpamScope",
+			"ModifyLaunchTemplate",
+			"ModifyManagedPrefixList",
+			"ModifyNetworkInterfaceAttribute",
+			"ModifyPrivateDnsNameOptions",
+			"ModifyReservedInstances",
+			"ModifySecurityGroupRules",
+			"ModifySnapshotAttribute",
+			"ModifySnapshotTier",
+			"ModifySpotFleetRequest",
+			"ModifySubnetAttribute",
+			"ModifyTrafficMirrorFilterNetworkServices",
+			"ModifyTrafficMirrorFilterRule",
+			"ModifyTrafficMirrorSession",
+			"ModifyTransitGateway",
+			"ModifyTransitGatewayPrefixListReference",
+			"ModifyTransitGatewayVpcAttachment",
+			"ModifyVolume",
+			"ModifyVolumeAttribute",
+			"ModifyVpcAttribute",
+			"ModifyVpcEndpoint",
+			"ModifyVpcEndpointConnectionNotification",
+			"ModifyVpcEndpointServiceConfiguration",
+			"ModifyVpcEndpointServicePayerResponsibility",
+			"ModifyVpcEndpointServicePermissions",
+			"ModifyVpcPeeringConnectionOptions",
+			"ModifyVpcTenancy",
+			"ModifyVpnConnection",
+			"ModifyVpnConnectionOptions",
+			"ModifyVpnTunnelCertificate",
+			"ModifyVpnTunnelOptions",
+			"MonitorInstances",
+			"MoveAddressToVpc",
+			"MoveByoipCidrToIpam",
+			"ProvisionByoipCidr",
+			"ProvisionIpamPoolCidr",
+			"ProvisionPublicIpv4PoolCidr",
+			"PurchaseHostReservation",
+			"PurchaseReservedInstancesOffering",
+			"PurchaseScheduledInstances",
+			"RebootInstances",
+			"RegisterImage",
+			"RegisterInstanceEventNotificationAttributes",
+			"RegisterTransitGatewayMulticastGroupMembers",
+			"RegisterTransitGatewayMulticastGroupSources",
+			"RejectTransitGatewayMulticastDomainAssociations",
+			"RejectTransitGatewayPeeringAttachment",
+			"RejectTransitGatewayVpcAttachment",
+			"RejectVpcEndpointConnections",
+			"RejectVpcPeeringConnection",
+			"ReleaseAddress",
+			"ReleaseHosts",
+			"ReleaseIpamPoolAllocation",
+			"ReplaceIamInstanceProfileAssociation",
+			"ReplaceNetworkAclAssociation",
+			"ReplaceNetworkAclEntry",
+			"ReplaceRoute",
+			"ReplaceRouteTableAssociation",
+			"ReplaceTransitGatewayRoute",
+			"ReportInstanceStatus",
+			"RequestSpotFleet",
+			"RequestSpotInstances",
+			"ResetAddressAttribute",
+			"ResetEbsDefaultKmsKeyId",
+			"ResetFpgaImageAttribute",
+			"ResetImageAttribute",
+			"ResetInstanceAttribute",
+			"ResetNetworkInterfaceAttribute",
+			"ResetSnapshotAttribute",
+			"RestoreAddressToClassic",
+			"RestoreImageFromRecycleBin",
+			"RestoreManagedPrefixListVersion",
+			"RestoreSnapshotFromRecycleBin",
+			"RestoreSnapshotTier",
+			"RevokeClientVpnIngress",
+			"RevokeSecurityGroupEgress",
+			"RevokeSecurityGroupIngress",
+			"RunInstances",
+			"RunScheduledInstances",
+			"SearchLocalGatewayRoutes",
+			"SearchTransitGatewayMulticastGroups",
+			"SearchTransitGatewayRoutes",
+			"SendDiagnosticInterrupt",
+			"StartInstances",
+			"StartNetworkInsightsAccessScopeAnalysis",
+			"StartNetworkInsightsAnalysis",
+			"StartVpcEndpointServicePrivateDnsVerification",
+			"StopInstances",
+			"TerminateClientVpnConnections",
+			"TerminateInstances",
+			"UnassignIpv6Addresses",
+			"UnassignPrivateIpAddresses",
+			"UnmonitorInstances",
+			"UpdateSecurityGroupRuleDescriptionsEgress",
+			"UpdateSecurityGroupRuleDescriptionsIngress",
+			"WithdrawByoipCidr",
+		},
+	},
+	"ecr": {
+		Actions: []string{
+			"BatchCheckLayerAvailability",
+			"BatchDeleteImage",
+			"BatchGetImage",
+			"BatchGetRepositoryScanningConfiguration",
+			"BatchImportUpstreamImage",
+			"CompleteLayerUpload",
+			"CreatePullThroughCacheRule",
+			"CreateRepository",
+			"DeleteLifecyclePolicy",
+			"DeletePullThroughCacheRule",
+			"DeleteRegistryPolicy",
+			"DeleteRepository",
+			"DeleteRepositoryPolicy",
+			"DescribeImageReplicationStatus",
+			"DescribeImageScanFindings",
+			"DescribeImages",
+			"DescribePullThroughCacheRules",
+			"DescribeRegistry",
+			"DescribeRepositories",
+			"GetAuthorizationToken",
+			"GetDownloadUrlForLayer",
+			"GetLifecyclePolicy",
+			"GetLifecyclePolicyPreview",
+			"GetRegistryPolicy",
+			"GetRegistryScanningConfiguration",
+			"GetRepositoryPolicy",
+			"InitiateLayerUpload",
+			"ListImages",
+			"ListTagsForResource",
+			"PutImage",
+			"PutImageScanningConfiguration",
+			"PutImageTagMutability",
+			"PutLifecyclePolicy",
+			"PutRegistryPolicy",
+			"PutRegistryScanningConfiguration",
+			"PutReplicationConfiguration",
+			"ReplicateImage",
+			"SetRepositoryPolicy",
+			"StartImageScan",
+			"StartLifecyclePolicyPreview",
+			"TagResource",
+			"UntagResource",
+			"UploadLayerPart",
+		},
+		WildcardOnlyActions: []string{
+			"CreatePullThroughCacheRule",
+			"DeletePullThroughCacheRule",
+			"DeleteRegistryPolicy",
+			"PutRegistryPolicy",
+			"PutRegistryScanningConfiguration",
+			"PutReplicationConfiguration",
+		},
+	},
+	"iam": {
+		Actions: []string{
+			"AddClientIDToOpenIDConnectProvider",
+			"AddRoleToInstanceProfile",
+			"AddUserToGroup",
+			"AttachGroupPolicy",
+			"AttachRolePolicy",
+			"AttachUserPolicy",
+			"ChangePassword",
+			"CreateAccessKey",
+			"CreateAccountAlias",
+			"CreateGroup",
+			"CreateInstanceProfile",
+			"CreateLoginProfile",
+			"CreateOpenIDConnectProvider",
+			"CreatePolicy",
+			"CreatePolicyVersion",
+			"CreateRole",
+			"CreateSAMLProvider",
+			"CreateServiceLinkedRole",
+			"CreateServiceSpecificCredential",
+			"CreateUser",
+			"CreateVirtualMFADevice",
+			"DeactivateMFADevice",
+			"DeleteAccessKey",
+			"DeleteAccountAlias",
+			"DeleteAccountPasswordPolicy",
+			"DeleteGroup",
+			"DeleteGroupPolicy",
+			"DeleteInstanceProfile",
+			"DeleteLoginProfile",
+			"DeleteOpenIDConnectProvider",
+			"DeletePolicy",
+			"DeletePolicyVersion",
+			"DeleteRole",
+			"DeleteRolePermissionsBoundary",
+			"DeleteRolePolicy",
+			"DeleteSAMLProvider",
+			"DeleteSSHPublicKey",
+			"DeleteServerCertificate",
+			"DeleteServiceLinkedRole",
+			"DeleteServiceSpecificCredential",
+			"DeleteSigningCertificate",
+			"DeleteUser",
+			"DeleteUserPermissionsBoundary",
+			"DeleteUserPolicy",
+			"DeleteVirtualMFADevice",
+			"DetachGroupPolicy",
+			"DetachRolePolicy",
+			"DetachUserPolicy",
+			"EnableMFADevice",
+			"GenerateCredentialReport",
+			"GenerateOrganizationsAccessReport",
+			"GenerateServiceLastAccessedDetails",
+			"GetAccessKeyLastUsed",
+			"GetAccountAuthorizationDetails",
+			"GetAccountPasswordPolicy",
+			"GetAccountSummary",
+			"GetContextKeysForCustomPolicy",
+			"GetContextKeysForPrincipalPolicy",
+			"GetCredentialReport",
+			"GetGroup",
+			"GetGroupPolicy",
+			"GetInstanceProfile",
+			"GetLoginProfile",
+			"GetOpenIDConnectProvider",
+			"GetOrganizationsAccessReport",
+			"GetPolicy",
+			"GetPolicyVersion",
+			"GetRole",
+			"GetRolePolicy",
+			"GetSAMLProvider",
+			"GetSSHPublicKey",
+			"GetServerCertificate",
+			"GetServiceLastAccessedDetails",
+			"GetServiceLastAccessedDetailsWithEntities",
+			"GetServiceLinkedRoleDeletionStatus",
+			"GetUser",
+			"GetUserPolicy",
+			"ListAccessKeys",
+			"ListAccountAliases",
+			"ListAttachedGroupPolicies",
+			"ListAttachedRolePolicies",
+			"ListAttachedUserPolicies",
+			"ListEntitiesForPolicy",
+			"ListGroupPolicies",
+			"ListGroups",
+			"ListGroupsForUser",
+			"ListInstanceProfileTags",
+			"ListInstanceProfiles",
+			"ListInstanceProfilesForRole",
+			"ListMFADeviceTags",
+			"ListMFADevices",
+			"ListOpenIDConnectProviderTags",
+			"ListOpenIDConnectProviders",
+			"ListPolicies",
+			"ListPoliciesGrantingServiceAccess",
+			"ListPolicyTags",
+			"ListPolicyVersions",
+			"ListRolePolicies",
+			"ListRoleTags",
+			"ListRoles",
+			"ListSAMLProviderTags",
+			"ListSAMLProviders",
+			"ListSSHPublicKeys",
+			"ListServerCertificateTags",
+			"ListServerCertificates",
+			"ListServiceSpecificCredentials",
+			"ListSigningCertificates",
+			"ListUserPolicies",
+			"ListUserTags",
+			"ListUsers",
+			"ListVirtualMFADevices",
+			"PassRole",
+			"PutGroupPolicy",
+			"PutRolePermissionsBoundary",
+			"PutRolePolicy",
+			"PutUserPermissionsBoundary",
+			"PutUserPolicy",
+			"RemoveClientIDFromOpenIDConnectProvider",
+			"RemoveRoleFromInstanceProfile",
+			"RemoveUserFromGroup",
+			"ResetServiceSpecificCredential",
+			"ResyncMFADevice",
+			"SetDefaultPolicyVersion",
+			"SetSecurityTokenServicePreferences",
+			"SimulateCustomPolicy",
+			"SimulatePrincipalPolicy",
+			"TagInstanceProfile",
+			"TagMFADevice",
+			"TagOpenIDConnectProvider",
+			"TagPolicy",
+			"TagRole",
+			"TagSAMLProvider",
+			"TagServerCertificate",
+			"TagUser",
+			"UntagInstanceProfile",
+			"UntagMFADevice",
+			"UntagOpenIDConnectProvider",
+			"UntagPolicy",
+			"UntagRole",
+			"UntagSAMLProvider",
+			"UntagServerCertificate",
+			"UntagUser",
+			"UpdateAccessKey",
+			"UpdateAccountPasswordPolicy",
+			"UpdateAssumeRolePolicy",
+			"UpdateGroup",
+			"UpdateLoginProfile",
+			"UpdateOpenIDConnectProviderThumbprint",
+			"UpdateRole",
+			"UpdateRoleDescription",
+			"UpdateSAMLProvider",
+			"UpdateSSHPublicKey",
+			"UpdateServerCertificate",
+			"UpdateServiceSpecificCredential",
+			"UpdateSigningCertificate",
+			"UpdateUser",
+			"UploadSSHPublicKey",
+			"UploadServerCertificate",
+			"UploadSigningCertificate",
+		},
+		ConditionKeys: []string{
+			"iam:AWSServiceName",
+			"iam:AssociatedResourceArn",
+			"iam:FIDO-FIPS-140-2-certification",
+			"iam:FIDO-FIPS-140-3-certification",
+			"iam:FIDO-certification",
+			"iam:OrganizationsPolicyId",
+			"iam:PassedToService",
+			"iam:PermissionsBoundary",
+			"iam:PolicyARN",
+			"iam:RegisterSecurityKey",
+			"iam:ResourceTag/*",
+		},
+		WildcardOnlyActions: []string{
+			"CreateAccountAlias",
+			"DeleteAccountAlias",
+			"DeleteAccountPasswordPolicy",
+			"GenerateCredentialReport",
+			"SetSecurityTokenServicePreferences",
+			"UpdateAccountPasswordPolicy",
+		},
+	},
+	"kms": {
+		Actions: []string{
+			"CancelKeyDeletion",
+			"ConnectCustomKeyStore",
+			"CreateAlias",
+			"CreateCustomKeyStore",
+			"CreateGrant",
+			"CreateKey",
+			"Decrypt",
+			"DeleteAlias",
+			"DeleteCustomKeyStore",
+			"DeleteImportedKeyMaterial",
+			"DescribeCustomKeyStores",
+			"DescribeKey",
+			"DisableKey",
+			"DisableKeyRotation",
+			"DisconnectCustomKeyStore",
+			"EnableKey",
+			"EnableKeyRotation",
+			"Encrypt",
+			"GenerateDataKey",
+			"GenerateDataKeyPair",
+			"GenerateDataKeyPairWithoutPlaintext",
+			"GenerateDataKeyWithoutPlaintext",
+			"GenerateMac",
+			"GenerateRandom",
+			"GetKeyPolicy",
+			"GetKeyRotationStatus",
+			"GetParametersForImport",
+			"GetPublicKey",
+			"ImportKeyMaterial",
+			"ListAliases",
+			"ListGrants",
+			"ListKeyPolicies",
+			"ListKeys",
+			"ListResourceTags",
+			"ListRetirableGrants",
+			"PutKeyPolicy",
+			"ReEncryptFrom",
+			"ReEncryptTo",
+			"ReplicateKey",
+			"RetireGrant",
+			"RevokeGrant",
+			"ScheduleKeyDeletion",
+			"Sign",
+			"SynchronizeMultiRegionKey",
+			"TagResource",
+			"UntagResource",
+			"UpdateAlias",
+			"UpdateCustomKeyStore",
+			"UpdateKeyDescription",
+			"UpdatePrimaryRegion",
+			"Verify",
+			"VerifyMac",
+		},
+		ConditionKeys: []string{
+			"kms:BypassPolicyLockoutSafetyCheck",
+			"kms:CallerAccount",
+			"kms:CustomerMasterKeySpec",
+			"kms:CustomerMasterKeyUsage",
+			"kms:DataKeyPairSpec",
+			"kms:EncryptionAlgorithm",
+			"kms:EncryptionContext:*",
+			"kms:EncryptionContextKeys",
+			"kms:ExpirationModel",
+			"kms:GrantConstraintType",
+			"kms:GrantIsForAWSResource",
+			"kms:GrantOperations",
+			"kms:GranteePrincipal",
+			"kms:KeyOrigin",
+			"kms:KeySpec",
+			"kms:KeyUsage",
+			"kms:MacAlgorithm",
+			"kms:MessageType",
+			"kms:MultiRegion",
+			"kms:MultiRegionKeyType",
+			"kms:PrimaryRegion",
+			"kms:ReEncryptOnSameKey",
+			"kms:RecipientAttestation:*",
+			"kms:ReplicaRegion",
+			"kms:RequestAlias",
+			"kms:ResourceAliases",
+			"kms:RetiringPrincipal",
+			"kms:SigningAlgorithm",
+			"kms:ValidTo",
+			"kms:ViaService",
+			"kms:WrappingAlgorithm",
+			"kms:WrappingKeySpec",
+		},
+		WildcardOnlyActions: []string{
+			"ConnectCustomKeyStore",
+			"CreateCustomKeyStore",
+			"CreateKey",
+			"DeleteCustomKeyStore",
+			"DisconnectCustomKeyStore",
+			"UpdateCustomKeyStore",
+		},
+	},
+	"lambda": {
+		Actions: []string{
+			"AddLayerVersionPermission",
+			"AddPermission",
+			"CreateAlias",
+			"CreateCodeSigningConfig",
+			"CreateEventSourceMapping",
+			"CreateFunction",
+			"CreateFunctionUrlConfig",
+			"DeleteAlias",
+			"DeleteCodeSigningConfig",
+			"DeleteEventSourceMapping",
+			"DeleteFunction",
+			"DeleteFunctionCodeSigningConfig",
+			"DeleteFunctionConcurrency",
+			"DeleteFunctionEventInvokeConfig",
+			"DeleteFunctionUrlConfig",
+			"DeleteLayerVersion",
+			"DeleteProvisionedConcurrencyConfig",
+			"DisableReplication",
+			"EnableReplication",
+			"GetAccountSettings",
+			"GetAlias",
+			"GetCodeSigningConfig",
+			"GetEventSourceMapping",
+			"GetFunction",
+			"GetFunctionCodeSigningConfig",
+			"GetFunctionConcurrency",
+			"GetFunctionConfiguration",
+			"GetFunctionEventInvokeConfig",
+			"GetFunctionUrlConfig",
+			"GetLayerVersion",
+			"GetLayerVersionPolicy",
+			"GetPolicy",
+			"GetProvisionedConcurrencyConfig",
+			"InvokeAsync",
+			"InvokeFunction",
+			"InvokeFunctionUrl",
+			"ListAliases",
+			"ListCodeSigningConfigs",
+			"ListEventSourceMappings",
+			"ListFunctionEventInvokeConfigs",
+			"ListFunctionUrlConfigs",
+			"ListFunctions",
+			"ListFunctionsByCodeSigningConfig",
+			"ListLayerVersions",
+			"ListLayers",
+			"ListProvisionedConcurrencyConfigs",
+			"ListTags",
+			"ListVersionsByFunction",
+			"PublishLayerVersion",
+			"PublishVersion",
+			"PutFunctionCodeSigningConfig",
+			"PutFunctionConcurrency",
+			"PutFunctionEventInvokeConfig",
+			"PutProvisionedConcurrencyConfig",
+			"RemoveLayerVersionPermission",
+			"RemovePermission",
+			"TagResource",
+			"UntagResource",
+			"UpdateAlias",
+			"UpdateCodeSigningConfig",
+			"UpdateEventSourceMapping",
+			"UpdateFunctionCode",
+			"UpdateFunctionCodeSigningConfig",
+			"UpdateFunctionConfiguration",
+			"UpdateFunctionEventInvokeConfig",
+			"UpdateFunctionUrlConfig",
+		},
+		ConditionKeys: []string{
+			"lambda:CodeSigningConfigArn",
+			"lambda:EventSourceToken",
+			"lambda:FunctionArn",
+			"lambda:FunctionUrlAuthType",
+			"lambda:Layer",
+			"lambda:Principal",
+			"lambda:SecurityGroupIds",
+			"lambda:SourceFunctionArn",
+			"lambda:SubnetIds",
+			"lambda:VpcIds",
+		},
+		WildcardOnlyActions: []string{
+			"CreateCodeSigningConfig",
+			"CreateEventSourceMapping",
+		},
+	},
+	"logs": {
+		Actions: []string{
+			"AssociateKmsKey",
+			"CancelExportTask",
+			"CreateExportTask",
+			"CreateLogDelivery",
+			"CreateLogGroup",
+			"CreateLogStream",
+			"DeleteDataProtectionPolicy",
+			"DeleteDestination",
+			"DeleteLogDelivery",
+			"DeleteLogGroup",
+			"DeleteLogStream",
+			"DeleteMetricFilter",
+			"DeleteQueryDefinition",
+			"DeleteResourcePolicy",
+			"DeleteRetentionPolicy",
+			"DeleteSubscriptionFilter",
+			"DescribeDestinations",
+			"DescribeExportTasks",
+			"DescribeLogGroups",
+			"DescribeLogStreams",
+			"DescribeMetricFilters",
+			"DescribeQueries",
+			"DescribeQueryDefinitions",
+			"DescribeResourcePolicies",
+			"DescribeSubscriptionFilters",
+			"DisassociateKmsKey",
+			"FilterLogEvents",
+			"GetDataProtectionPolicy",
+			"GetLogDelivery",
+			"GetLogEvents",
+			"GetLogGroupFields",
+			"GetLogRecord",
+			"GetQueryResults",
+			"Link",
+			"ListLogDeliveries",
+			"ListTagsForResource",
+			"ListTagsLogGroup",
+			"PutDataProtectionPolicy",
+			"PutDestination",
+			"PutDestinationPolicy",
+			"PutLogEvents",
+			"PutMetricFilter",
+			"PutQueryDefinition",
+			"PutResourcePolicy",
+			"PutRetentionPolicy",
+			"PutSubscriptionFilter",
+			"StartLiveTail",
+			"StartQuery",
+			"StopLiveTail",
+			"StopQuery",
+			"TagLogGroup",
+			"TagResource",
+			"TestMetricFilter",
+			"Unmask",
+			"UntagLogGroup",
+			"UntagResource",
+			"UpdateLogDelivery",
+		},
+		WildcardOnlyActions: []string{
+			"CancelExportTask",
+			"CreateLogDelivery",
+			"DeleteLogDelivery",
+			"DeleteQueryDefinition",
+			"DeleteResourcePolicy",
+			"Link",
+			"PutQueryDefinition",
+			"PutResourcePolicy",
+			"StopQuery",
+			"UpdateLogDelivery",
+		},
+	},
+	"s3": {
+		Actions: []string{
+			"AbortMultipartUpload",
+			"BypassGovernanceRetention",
+			"CreateAccessPoint",
+			"CreateAccessPointForObjectLambda",
+			"CreateBucket",
+			"CreateJob",
+			"CreateMultiRegionAccessPoint",
+			"DeleteAccessPoint",
+			"DeleteAccessPointForObjectLambda",
+			"DeleteAccessPointPolicy",
+			"DeleteAccessPointPolicyForObjectLambda",
+			"DeleteBucket",
+			"DeleteBucketOwnershipControls",
+			"DeleteBucketPolicy",
+			"DeleteBucketWebsite",
+			"DeleteJobTagging",
+			"DeleteMultiRegionAccessPoint",
+			"DeleteObject",
+			"DeleteObjectTagging",
+			"DeleteObjectVersion",
+			"DeleteObjectVersionTagging",
+			"DeleteStorageLensConfiguration",
+			"DeleteStorageLensConfigurationTagging",
+			"DescribeJob",
+			"DescribeMultiRegionAccessPointOperation",
+			"GetAccelerateConfiguration",
+			"GetAccessPoint",
+			"GetAccessPointConfigurationForObjectLambda",
+			"GetAccessPointForObjectLambda",
+			"GetAccessPointPolicy",
+			"GetAccessPointPolicyForObjectLambda",
+			"GetAccessPointPolicyStatus",
+			"GetAccessPointPolicyStatusForObjectLambda",
+			"GetAccountPublicAccessBlock",
+			"GetAnalyticsConfiguration",
+			"GetBucketAcl",
+			"GetBucketCORS",
+			"GetBucketLocation",
+			"GetBucketLogging",
+			"GetBucketNotification",
+			"GetBucketObjectLockConfiguration",
+			"GetBucketOwnershipControls",
+			"GetBucketPolicy",
+			"GetBucketPolicyStatus",
+			"GetBucketPublicAccessBlock",
+			"GetBucketRequestPayment",
+			"GetBucketTagging",
+			"GetBucketVersioning",
+			"GetBucketWebsite",
+			"GetEncryptionConfiguration",
+			"GetIntelligentTieringConfiguration",
+			"GetInventoryConfiguration",
+			"GetJobTagging",
+			"GetLifecycleConfiguration",
+			"GetMetricsConfiguration",
+			"GetMultiRegionAccessPoint",
+			"GetMultiRegionAccessPointPolicy",
+			"GetMultiRegionAccessPointPolicyStatus",
+			"GetObject",
+			"GetObjectAcl",
+			"GetObjectAttributes",
+			"GetObjectLegalHold",
+			"GetObjectRetention",
+			"GetObjectTagging",
+			"GetObjectTorrent",
+			"GetObjectVersion",
+			"GetObjectVersionAcl",
+			"GetObjectVersionAttributes",
+			"GetObjectVersionForReplication",
+			"GetObjectVersionTagging",
+			"GetObjectVersionTorrent",
+			"GetReplicationConfiguration",
+			"GetStorageLensConfiguration",
+			"GetStorageLensConfigurationTagging",
+			"GetStorageLensDashboard",
+			"InitiateReplication",
+			"ListAccessPoints",
+			"ListAccessPointsForObjectLambda",
+			"ListAllMyBuckets",
+			"ListBucket",
+			"ListBucketMultipartUploads",
+			"ListBucketVersions",
+			"ListJobs",
+			"ListMultiRegionAccessPoints",
+			"ListMultipartUploadParts",
+			"ListStorageLensConfigurations",
+			"ObjectOwnerOverrideToBucketOwner",
+			"PutAccelerateConfiguration",
+			"PutAccessPointConfigurationForObjectLambda",
+			"PutAccessPointPolicy",
+			"PutAccessPointPolicyForObjectLambda",
+			"PutAccessPointPublicAccessBlock",
+			"PutAccountPublicAccessBlock",
+			"PutAnalyticsConfiguration",
+			"PutBucketAcl",
+			"PutBucketCORS",
+			"PutBucketLogging",
+			"PutBucketNotification",
+			"PutBucketObjectLockConfiguration",
+			"PutBucketOwnershipControls",
+			"PutBucketPolicy",
+			"PutBucketPublicAccessBlock",
+			"PutBucketRequestPayment",
+			"PutBucketTagging",
+			"PutBucketVersioning",
+			"PutBucketWebsite",
+			"PutEncryptionConfiguration",
+			"PutIntelligentTieringConfiguration",
+			"PutInventoryConfiguration",
+			"PutJobTagging",
+			"PutLifecycleConfiguration",
+			"PutMetricsConfiguration",
+			"PutMultiRegionAccessPointPolicy",
+			"PutObject",
+			"PutObjectAcl",
+			"PutObjectLegalHold",
+			"PutObjectRetention",
+			"PutObjectTagging",
+			"PutObjectVersionAcl",
+			"PutObjectVersionTagging",
+			"PutReplicationConfiguration",
+			"PutStorageLensConfiguration",
+			"PutStorageLensConfigurationTagging",
+			"ReplicateDelete",
+			"ReplicateObject",
+			"ReplicateTags",
+			"RestoreObject",
+			"UpdateJobPriority",
+			"UpdateJobStatus",
+		},
+		ConditionKeys: []string{
+			"s3:AccessPointNetworkOrigin",
+			"s3:DataAccessPointAccount",
+			"s3:DataAccessPointArn",
+			"s3:ExistingJobOperation",
+			"s3:ExistingJobPriority",
+			"s3:ExistingObjectTag/*",
+			"s3:JobSuspendedCause",
+			"s3:LocationConstraint",
+			"s3:RequestJobOperation",
+			"s3:RequestJobPriority",
+			"s3:RequestObjectTag/*",
+			"s3:RequestObjectTagKeys",
+			"s3:ResourceAccount",
+			"s3:TlsVersion",
+			"s3:VersionId",
+			"s3:authType",
+			"s3:delimiter",
+			"s3:locationconstraint",
+			"s3:max-keys",
+			"s3:object-lock-legal-hold",
+			"s3:object-lock-mode",
+			"s3:object-lock-remaining-retention-days",
+			"s3:object-lock-retain-until-date",
+			"s3:prefix",
+			"s3:signatureAge",
+			"s3:signatureversion",
+			"s3:versionid",
+			"s3:x-amz-acl",
+			"s3:x-amz-content-sha256",
+			"s3:x-amz-copy-source",
+			"s3:x-amz-grant-full-control",
+			"s3:x-amz-grant-read",
+			"s3:x-amz-grant-read-acp",
+			"s3:x-amz-grant-write",
+			"s3:x-amz-grant-write-acp",
+			"s3:x-amz-metadata-directive",
+			"s3:x-amz-object-ownership",
+			"s3:x-amz-server-side-encryption",
+			"s3:x-amz-server-side-encryption-aws-kms-key-id",
+			"s3:x-amz-server-side-encryption-customer-algorithm",
+			"s3:x-amz-storage-class",
+			"s3:x-amz-website-redirect-location",
+		},
+		WildcardOnlyActions: []string{
+			"CreateJob",
+			"PutAccessPointPublicAccessBlock",
+			"PutAccountPublicAccessBlock",
+			"PutStorageLensConfiguration",
+		},
+	},
+	"secretsmanager": {
+		Actions: []string{
+			"BatchGetSecretValue",
+			"CancelRotateSecret",
+			"CreateSecret",
+			"DeleteResourcePolicy",
+			"DeleteSecret",
+			"DescribeSecret",
+			"GetRandomPassword",
+			"GetResourcePolicy",
+			"GetSecretValue",
+			"ListSecretVersionIds",
+			"ListSecrets",
+			"PutResourcePolicy",
+			"PutSecretValue",
+			"RemoveRegionsFromReplication",
+			"ReplicateSecretToRegions",
+			"RestoreSecret",
+			"RotateSecret",
+			"StopReplicationToReplica",
+			"TagResource",
+			"UntagResource",
+			"UpdateSecret",
+			"UpdateSecretVersionStage",
+			"ValidateResourcePolicy",
+		},
+		ConditionKeys: []string{
+			"secretsmanager:AddReplicaRegions",
+			"secretsmanager:BlockPublicPolicy",
+			"secretsmanager:Description",
+			"secretsmanager:ForceDeleteWithoutRecovery",
+			"secretsmanager:ForceOverwriteReplicaSecret",
+			"secretsmanager:KmsKeyId",
+			"secretsmanager:ModifyRotationRules",
+			"secretsmanager:Name",
+			"secretsmanager:RecoveryWindowInDays",
+			"secretsmanager:ResourceTag/*",
+			"secretsmanager:RotateImmediately",
+			"secretsmanager:RotationLambdaARN",
+			"secretsmanager:SecretId",
+			"secretsmanager:SecretPrimaryRegion",
+			"secretsmanager:VersionId",
+			"secretsmanager:VersionStage",
+			"secretsmanager:resource/AllowRotationLambdaArn",
+		},
+	},
+	"sns": {
+		Actions: []string{
+			"AddPermission",
+			"CheckIfPhoneNumberIsOptedOut",
+			"ConfirmSubscription",
+			"CreatePlatformApplication",
+			"CreatePlatformEndpoint",
+			"CreateSMSSandboxPhoneNumber",
+			"CreateTopic",
+			"DeleteEndpoint",
+			"DeletePlatformApplication",
+			"DeleteSMSSandboxPhoneNumber",
+			"DeleteTopic",
+			"GetDataProtectionPolicy",
+			"GetEndpointAttributes",
+			"GetPlatformApplicationAttributes",
+			"GetSMSAttributes",
+			"GetSMSSandboxAccountStatus",
+			"GetSubscriptionAttributes",
+			"GetTopicAttributes",
+			"ListEndpointsByPlatformApplication",
+			"ListOriginationNumbers",
+			"ListPhoneNumbersOptedOut",
+			"ListPlatformApplications",
+			"ListSMSSandboxPhoneNumbers",
+			"ListSubscriptions",
+			"ListSubscriptionsByTopic",
+			"ListTagsForResource",
+			"ListTopics",
+			"OptInPhoneNumber",
+			"Publish",
+			"PutDataProtectionPolicy",
+			"RemovePermission",
+			"SetEndpointAttributes",
+			"SetPlatformApplicationAttributes",
+			"SetSMSAttributes",
+			"SetSubscriptionAttributes",
+			"SetTopicAttributes",
+			"Subscribe",
+			"TagResource",
+			"Unsubscribe",
+			"UntagResource",
+			"VerifySMSSandboxPhoneNumber",
+		},
+		ConditionKeys: []string{
+			"sns:Endpoint",
+			"sns:Protocol",
+		},
+		WildcardOnlyActions: []string{
+			"ConfirmSubscription",
+			"CreatePlatformApplication",
+			"CreatePlatformEndpoint",
+			"CreateSMSSandboxPhoneNumber",
+			"DeleteEndpoint",
+			"DeletePlatformApplication",
+			"DeleteSMSSandboxPhoneNumber",
+			"OptInPhoneNumber",
+			"SetEndpointAttributes",
+			"SetPlatformApplicationAttributes",
+			"SetSMSAttributes",
+			"SetSubscriptionAttributes",
+			"Unsubscribe",
+			"VerifySMSSandboxPhoneNumber",
+		},
+	},
+	"sqs": {
+		Actions: []string{
+			"AddPermission",
+			"ChangeMessageVisibility",
+			"CreateQueue",
+			"DeleteMessage",
+			"DeleteQueue",
+			"GetQueueAttributes",
+			"GetQueueUrl",
+			"ListDeadLetterSourceQueues",
+			"ListQueueTags",
+			"ListQueues",
+			"PurgeQueue",
+			"ReceiveMessage",
+			"RemovePermission",
+			"SendMessage",
+			"SetQueueAttributes",
+			"TagQueue",
+			"UntagQueue",
+		},
+	},
+	"sts": {
+		Actions: []string{
+			"AssumeRole",
+			"AssumeRoleWithSAML",
+			"AssumeRoleWithWebIdentity",
+			"DecodeAuthorizationMessage",
+			"GetAccessKeyInfo",
+			"GetCallerIdentity",
+			"GetFederationToken",
+			"GetServiceBearerToken",
+			"GetSessionToken",
+			"SetSourceIdentity",
+			"TagSession",
+		},
+		ConditionKeys: []string{
+			"sts:AWSServiceName",
+			"sts:ExternalId",
+			"sts:RoleSessionName",
+			"sts:SourceIdentity",
+			"sts:TransitiveTagKeys",
+		},
+		WildcardOnlyActions: []string{
+			"DecodeAuthorizationMessage",
+		},
+	},
+}
+
+// policyGlobalConditionKeys are the AWS global condition context keys
+// (https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_condition-keys.html).
+var policyGlobalConditionKeys = []string{
+	"aws:CalledVia",
+	"aws:CalledViaFirst",
+	"aws:CalledViaLast",
+	"aws:CurrentTime",
+	"aws:Ec2InstanceSourcePrivateIPv4",
+	"aws:Ec2InstanceSourceVpc",
+	"aws:EpochTime",
+	"aws:FederatedProvider",
+	"aws:MultiFactorAuthAge",
+	"aws:MultiFactorAuthPresent",
+	"aws:PrincipalAccount",
+	"aws:PrincipalArn",
+	"aws:PrincipalIsAWSService",
+	"aws:PrincipalOrgID",
+	"aws:PrincipalOrgPaths",
+	"aws:PrincipalServiceName",
+	"aws:PrincipalServiceNamesList",
+	"aws:PrincipalTag/*",
+	"aws:PrincipalType",
+	"aws:Referer",
+	"aws:RequestTag/*",
+	"aws:RequestedRegion",
+	"aws:ResourceAccount",
+	"aws:ResourceOrgID",
+	"aws:ResourceOrgPaths",
+	"aws:ResourceTag/*",
+	"aws:SecureTransport",
+	"aws:SourceAccount",
+	"aws:SourceArn",
+	"aws:SourceIdentity",
+	"aws:SourceIp",
+	"aws:SourceOrgID",
+	"aws:SourceOrgPaths",
+	"aws:SourceOwner",
+	"aws:SourceVpc",
+	"aws:SourceVpce",
+	"aws:TagKeys",
+	"aws:TokenIssueTime",
+	"aws:UserAgent",
+	"aws:ViaAWSService",
+	"aws:VpcSourceIp",
+	"aws:userid",
+	"aws:username",
+}
+
+// policyReadOnlyActionPrefixes are the prefixes of action names that do not modify resources.
+var policyReadOnlyActionPrefixes = []string{
+	"BatchGet",
+	"Check",
+	"Describe",
+	"Get",
+	"Head",
+	"List",
+	"Lookup",
+	"PartiQLSelect",
+	"Query",
+	"Scan",
+	"Search",
+	"Select",
+	"Validate",
+	"View",
+}
+
+// policyConditionKeyMatches returns whether a condition key matches one of the keys,
+// ignoring case as IAM does.
+func policyConditionKeyMatches(key string, keys []string) bool {
+	for _, k := range keys {
+		if strings.HasSuffix(k, "/*") || strings.HasSuffix(k, ":*") {
+			prefix := strings.TrimSuffix(k, "*")
+
+			if len(key) > len(prefix) && strings.EqualFold(key[:len(prefix)], prefix) {
+				return true
+			}
+
+			continue
+		}
+
+		if strings.EqualFold(key, k) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -223,6 +223,24 @@ func TestAccIAMPolicyDocumentDataSource_duplicateSid(t *testing.T) {
 	})
 }
 
+func TestAccIAMPolicyDocumentDataSource_invalid(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPolicyDocumentInvalidActionConfig,
+				ExpectError: regexp.MustCompile(`is not a valid action`),
+			},
+			{
+				Config:      testAccPolicyDocumentInvalidConditionOperatorConfig,
+				ExpectError: regexp.MustCompile(`is not a valid condition operator`),
+			},
+		},
+	})
+}
+
 // Reference: https://github.com/hashicorp/terraform-provider-aws/issues/10777
 func TestAccIAMPolicyDocumentDataSource_StatementPrincipalIdentifiers_stringAndSlice(t *testing.T) {
 	dataSourceName := "data.aws_iam_policy_document.test"
@@ -1063,6 +1081,30 @@ data "aws_iam_policy_document" "test" {
 }
 `
 
+var testAccPolicyDocumentInvalidActionConfig = `
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions   = ["s3GetObject"]
+    resources = ["*"]
+  }
+}
+`
+
+var testAccPolicyDocumentInvalidConditionOperatorConfig = `
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions   = ["s3:GetObject"]
+    resources = ["*"]
+
+    condition {
+      test     = "StringEqual"
+      variable = "aws:SourceVpc"
+      values   = ["vpc-12345678"]
+    }
+  }
+}
+`
+
 var testAccPolicyDocumentDuplicateBlankSidConfig = `
 data "aws_iam_policy_document" "test" {
   statement {
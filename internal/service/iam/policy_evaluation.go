@@ -0,0 +1,760 @@
+package iam
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+)
+
+const (
+	policyEvaluationDecisionAllowed      = "allowed"
+	policyEvaluationDecisionExplicitDeny = "explicitDeny"
+	policyEvaluationDecisionImplicitDeny = "implicitDeny"
+)
+
+func policyEvaluationDecision_Values() []string {
+	return []string{
+		policyEvaluationDecisionAllowed,
+		policyEvaluationDecisionExplicitDeny,
+		policyEvaluationDecisionImplicitDeny,
+	}
+}
+
+// policyEvaluationRequest is the request context a set of policies is evaluated against.
+type policyEvaluationRequest struct {
+	Action            string
+	PrincipalARN      string
+	ResourceARN       string
+	ResourceAccountID string
+
+	// Context holds the values of condition keys, by lower case key.
+	Context map[string][]string
+}
+
+// policyEvaluationPolicy is a policy document and the name it is reported with, e.g. "identity_policies[0]".
+type policyEvaluationPolicy struct {
+	Name string
+	Doc  *IAMPolicyDoc
+}
+
+// policyEvaluationPolicies are the policies that apply to a request.
+type policyEvaluationPolicies struct {
+	Identity               []policyEvaluationPolicy
+	Resource               *policyEvaluationPolicy
+	PermissionsBoundary    *policyEvaluationPolicy
+	Session                *policyEvaluationPolicy
+	ServiceControlPolicies []policyEvaluationPolicy
+}
+
+// policyEvaluationPrincipalScope controls how the Principal and NotPrincipal elements of a statement are matched.
+type policyEvaluationPrincipalScope int
+
+const (
+	// Principals are not matched, as in identity-based policies.
+	policyEvaluationPrincipalScopeNone policyEvaluationPrincipalScope = iota
+	// Only principals naming exactly the requesting user or role session match.
+	policyEvaluationPrincipalScopeExact
+	// Principals naming the requesting user, role or role session (or "*") match.
+	policyEvaluationPrincipalScopeDirect
+	// Principals naming the requester's account also match.
+	policyEvaluationPrincipalScopeAccount
+)
+
+// policyEvaluationStatement identifies a statement of an evaluated policy.
+type policyEvaluationStatement struct {
+	Policy string
+	Index  int
+	Sid    string
+	Effect string
+}
+
+type policyEvaluationResult struct {
+	Decision string
+	Reason   string
+
+	// Statement is the statement that decided an explicit deny or allow.
+	Statement *policyEvaluationStatement
+}
+
+// evaluatePolicies evaluates policies against a request using the IAM policy evaluation logic
+// (https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_evaluation-logic.html):
+//  1. An explicit deny in any policy denies the request.
+//  2. Each service control policy must allow the request.
+//  3. Within an account, a resource-based policy allowing the requesting principal itself allows the request.
+//     A resource-based policy that only allows the principal's account delegates the decision to IAM.
+//  4. Otherwise an identity-based policy must allow the request and, across accounts, so must the resource-based policy.
+//  5. The permissions boundary and session policy, if any, must also allow the request.
+func evaluatePolicies(policies policyEvaluationPolicies, request *policyEvaluationRequest) (*policyEvaluationResult, error) {
+	all := make([]policyEvaluationPolicy, 0)
+	all = append(all, policies.ServiceControlPolicies...)
+	if policies.Resource != nil {
+		all = append(all, *policies.Resource)
+	}
+	all = append(all, policies.Identity...)
+	if policies.PermissionsBoundary != nil {
+		all = append(all, *policies.PermissionsBoundary)
+	}
+	if policies.Session != nil {
+		all = append(all, *policies.Session)
+	}
+
+	for _, policy := range all {
+		scope := policyEvaluationPrincipalScopeNone
+		if policies.Resource != nil && policy.Name == policies.Resource.Name {
+			scope = policyEvaluationPrincipalScopeAccount
+		}
+
+		statement, err := policyEvaluationMatch(policy, request, "Deny", scope)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if statement != nil {
+			return &policyEvaluationResult{
+				Decision:  policyEvaluationDecisionExplicitDeny,
+				Reason:    fmt.Sprintf("denied by statement %d of %s", statement.Index, statement.Policy),
+				Statement: statement,
+			}, nil
+		}
+	}
+
+	for _, policy := range policies.ServiceControlPolicies {
+		statement, err := policyEvaluationMatch(policy, request, "Allow", policyEvaluationPrincipalScopeNone)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if statement == nil {
+			return &policyEvaluationResult{
+				Decision: policyEvaluationDecisionImplicitDeny,
+				Reason:   fmt.Sprintf("not allowed by %s", policy.Name),
+			}, nil
+		}
+	}
+
+	// exactAllow allows exactly the requesting user or role session, resourceAllow (also) allows
+	// the session's role or all principals ("*"), and accountAllow (also) allows the principal's account.
+	var exactAllow, resourceAllow, accountAllow *policyEvaluationStatement
+
+	if policies.Resource != nil {
+		var err error
+		exactAllow, err = policyEvaluationMatch(*policies.Resource, request, "Allow", policyEvaluationPrincipalScopeExact)
+
+		if err != nil {
+			return nil, err
+		}
+
+		resourceAllow = exactAllow
+
+		if resourceAllow == nil {
+			resourceAllow, err = policyEvaluationMatch(*policies.Resource, request, "Allow", policyEvaluationPrincipalScopeDirect)
+
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		accountAllow = resourceAllow
+
+		if accountAllow == nil {
+			accountAllow, err = policyEvaluationMatch(*policies.Resource, request, "Allow", policyEvaluationPrincipalScopeAccount)
+
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	crossAccount := policyEvaluationCrossAccount(request)
+
+	// A same-account resource-based policy allowing exactly the requesting user or role session is not limited
+	// by the permissions boundary or session policy. Allowing the session's role is, as is allowing "*".
+	if exactAllow != nil && !crossAccount {
+		return &policyEvaluationResult{
+			Decision:  policyEvaluationDecisionAllowed,
+			Reason:    fmt.Sprintf("allowed by statement %d of %s", exactAllow.Index, exactAllow.Policy),
+			Statement: exactAllow,
+		}, nil
+	}
+
+	var identityAllow *policyEvaluationStatement
+
+	for _, policy := range policies.Identity {
+		statement, err := policyEvaluationMatch(policy, request, "Allow", policyEvaluationPrincipalScopeNone)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if statement != nil {
+			identityAllow = statement
+			break
+		}
+	}
+
+	// A same-account resource-based policy allowing the requesting principal does not need an identity-based Allow.
+	allow := identityAllow
+
+	if allow == nil && resourceAllow != nil && !crossAccount {
+		allow = resourceAllow
+	}
+
+	if allow == nil && accountAllow != nil && !crossAccount {
+		return &policyEvaluationResult{
+			Decision: policyEvaluationDecisionImplicitDeny,
+			Reason:   fmt.Sprintf("statement %d of %s only allows the account, and no identity-based policy allows the request", accountAllow.Index, accountAllow.Policy),
+		}, nil
+	}
+
+	if allow == nil {
+		return &policyEvaluationResult{
+			Decision: policyEvaluationDecisionImplicitDeny,
+			Reason:   "not allowed by any identity-based or resource-based policy",
+		}, nil
+	}
+
+	if crossAccount && accountAllow == nil {
+		return &policyEvaluationResult{
+			Decision: policyEvaluationDecisionImplicitDeny,
+			Reason:   "cross-account request not allowed by the resource-based policy",
+		}, nil
+	}
+
+	for _, policy := range []*policyEvaluationPolicy{policies.PermissionsBoundary, policies.Session} {
+		if policy == nil {
+			continue
+		}
+
+		statement, err := policyEvaluationMatch(*policy, request, "Allow", policyEvaluationPrincipalScopeNone)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if statement == nil {
+			return &policyEvaluationResult{
+				Decision: policyEvaluationDecisionImplicitDeny,
+				Reason:   fmt.Sprintf("not allowed by %s", policy.Name),
+			}, nil
+		}
+	}
+
+	return &policyEvaluationResult{
+		Decision:  policyEvaluationDecisionAllowed,
+		Reason:    fmt.Sprintf("allowed by statement %d of %s", allow.Index, allow.Policy),
+		Statement: allow,
+	}, nil
+}
+
+// policyEvaluationCrossAccount returns whether the principal and the resource are in different accounts.
+func policyEvaluationCrossAccount(request *policyEvaluationRequest) bool {
+	principalAccountID := ""
+	if v, err := arn.Parse(request.PrincipalARN); err == nil {
+		principalAccountID = v.AccountID
+	}
+
+	resourceAccountID := request.ResourceAccountID
+	if resourceAccountID == "" {
+		if v, err := arn.Parse(request.ResourceARN); err == nil {
+			resourceAccountID = v.AccountID
+		}
+	}
+
+	return principalAccountID != "" && resourceAccountID != "" && principalAccountID != resourceAccountID
+}
+
+// policyEvaluationMatch returns the first statement of a policy with the effect that applies to the request, if any.
+// Principals are matched according to scope, which is policyEvaluationPrincipalScopeNone for all but resource-based policies.
+func policyEvaluationMatch(policy policyEvaluationPolicy, request *policyEvaluationRequest, effect string, scope policyEvaluationPrincipalScope) (*policyEvaluationStatement, error) {
+	for i, stmt := range policy.Doc.Statements {
+		if !strings.EqualFold(stmt.Effect, effect) {
+			continue
+		}
+
+		ok, err := policyEvaluationStatementMatches(stmt, request, scope)
+
+		if err != nil {
+			return nil, fmt.Errorf("error evaluating statement %d of %s: %w", i, policy.Name, err)
+		}
+
+		if ok {
+			return &policyEvaluationStatement{
+				Policy: policy.Name,
+				Index:  i,
+				Sid:    stmt.Sid,
+				Effect: stmt.Effect,
+			}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func policyEvaluationStatementMatches(stmt *IAMPolicyStatement, request *policyEvaluationRequest, scope policyEvaluationPrincipalScope) (bool, error) {
+	resourceBased := scope != policyEvaluationPrincipalScopeNone
+
+	switch {
+	case stmt.Actions != nil:
+		if !policyEvaluationActionMatches(policyStatementStrings(stmt.Actions), request.Action) {
+			return false, nil
+		}
+	case stmt.NotActions != nil:
+		if policyEvaluationActionMatches(policyStatementStrings(stmt.NotActions), request.Action) {
+			return false, nil
+		}
+	default:
+		return false, nil
+	}
+
+	switch {
+	case stmt.Resources != nil:
+		if !policyEvaluationResourceMatches(policyStatementStrings(stmt.Resources), request) {
+			return false, nil
+		}
+	case stmt.NotResources != nil:
+		if policyEvaluationResourceMatches(policyStatementStrings(stmt.NotResources), request) {
+			return false, nil
+		}
+	case !resourceBased:
+		// Identity-based policy statements must have a Resource or NotResource element.
+		return false, nil
+	}
+
+	if resourceBased {
+		switch {
+		case len(stmt.Principals) > 0:
+			if !policyEvaluationPrincipalMatches(stmt.Principals, request.PrincipalARN, scope) {
+				return false, nil
+			}
+		case len(stmt.NotPrincipals) > 0:
+			// Excluding the principal's account excludes the principal.
+			if policyEvaluationPrincipalMatches(stmt.NotPrincipals, request.PrincipalARN, policyEvaluationPrincipalScopeAccount) {
+				return false, nil
+			}
+		default:
+			return false, nil
+		}
+	}
+
+	for _, condition := range stmt.Conditions {
+		ok, err := policyEvaluationConditionMatches(condition, request)
+
+		if err != nil {
+			return false, err
+		}
+
+		if !ok {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func policyEvaluationActionMatches(patterns []string, action string) bool {
+	for _, pattern := range patterns {
+		if policyEvaluationGlob(strings.ToLower(pattern), strings.ToLower(action)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func policyEvaluationResourceMatches(patterns []string, request *policyEvaluationRequest) bool {
+	for _, pattern := range patterns {
+		pattern, ok := policyEvaluationSubstituteVariables(pattern, request, true)
+
+		if ok && policyEvaluationGlob(pattern, request.ResourceARN) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// policyEvaluationPrincipalMatches returns whether a principal matches a Principal or NotPrincipal element.
+// Unless scope is policyEvaluationPrincipalScopeExact, a role ARN matches the role's sessions and "*" matches
+// all principals. If scope is policyEvaluationPrincipalScopeAccount, an AWS account ID or account root ARN
+// matches all principals in the account.
+func policyEvaluationPrincipalMatches(principals IAMPolicyStatementPrincipalSet, principalARN string, scope policyEvaluationPrincipalScope) bool {
+	exact := scope == policyEvaluationPrincipalScopeExact
+	candidates := []string{principalARN}
+
+	if v, err := arn.Parse(principalARN); err == nil && !exact {
+		if scope == policyEvaluationPrincipalScopeAccount {
+			candidates = append(candidates, v.AccountID, arn.ARN{Partition: v.Partition, Service: "iam", AccountID: v.AccountID, Resource: "root"}.String())
+		}
+
+		// arn:aws:sts::123456789012:assumed-role/RoleName/SessionName is a session of arn:aws:iam::123456789012:role/RoleName.
+		if parts := strings.Split(v.Resource, "/"); v.Service == "sts" && len(parts) == 3 && parts[0] == "assumed-role" {
+			candidates = append(candidates, arn.ARN{Partition: v.Partition, Service: "iam", AccountID: v.AccountID, Resource: "role/" + parts[1]}.String())
+		}
+	}
+
+	for _, principal := range principals {
+		for _, identifier := range policyStatementStrings(principal.Identifiers) {
+			if identifier == "*" && !exact {
+				return true
+			}
+
+			for _, candidate := range candidates {
+				if identifier == candidate {
+					return true
+				}
+
+				// Role ARNs may include a path, e.g. arn:aws:iam::123456789012:role/path/RoleName.
+				if strings.Contains(identifier, ":role/") && strings.Contains(candidate, ":role/") {
+					if identifier[:strings.Index(identifier, ":role/")] == candidate[:strings.Index(candidate, ":role/")] &&
+						identifier[strings.LastIndex(identifier, "/"):] == candidate[strings.LastIndex(candidate, "/"):] {
+						return true
+					}
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+func policyEvaluationConditionMatches(condition IAMPolicyStatementCondition, request *policyEvaluationRequest) (bool, error) {
+	if !policyConditionOperatorValid(condition.Test) {
+		return false, fmt.Errorf("unknown condition operator: %s", condition.Test)
+	}
+
+	operator := condition.Test
+	setOperator := ""
+
+	for _, prefix := range []string{policyConditionForAllValuesPrefix, policyConditionForAnyValuePrefix} {
+		if strings.HasPrefix(strings.ToLower(operator), strings.ToLower(prefix)) {
+			setOperator = prefix
+			operator = operator[len(prefix):]
+			break
+		}
+	}
+
+	ifExists := false
+
+	if strings.HasSuffix(strings.ToLower(operator), strings.ToLower(policyConditionIfExistsSuffix)) {
+		ifExists = true
+		operator = operator[:len(operator)-len(policyConditionIfExistsSuffix)]
+	}
+
+	operator = strings.ToLower(operator)
+	contextValues, present := request.Context[strings.ToLower(condition.Variable)]
+	present = present && len(contextValues) > 0
+
+	// Values of the wildcard-matching operators are glob patterns.
+	glob := false
+
+	switch operator {
+	case "stringlike", "stringnotlike", "arnequals", "arnnotequals", "arnlike", "arnnotlike":
+		glob = true
+	}
+
+	var values []string
+	for _, v := range policyStatementStrings(condition.Values) {
+		v, ok := policyEvaluationSubstituteVariables(v, request, glob)
+
+		if ok {
+			values = append(values, v)
+		}
+	}
+
+	if operator == "null" {
+		for _, v := range values {
+			if strings.EqualFold(v, strconv.FormatBool(!present)) {
+				return true, nil
+			}
+		}
+
+		return false, nil
+	}
+
+	negated := strings.Contains(operator, "not")
+
+	if !present {
+		switch {
+		case ifExists:
+			return true, nil
+		case setOperator == policyConditionForAllValuesPrefix:
+			return true, nil
+		case setOperator == policyConditionForAnyValuePrefix:
+			return false, nil
+		default:
+			return negated, nil
+		}
+	}
+
+	switch setOperator {
+	case policyConditionForAllValuesPrefix:
+		for _, contextValue := range contextValues {
+			ok, err := policyEvaluationValueMatches(operator, negated, contextValue, values)
+
+			if err != nil || !ok {
+				return false, err
+			}
+		}
+
+		return true, nil
+	case policyConditionForAnyValuePrefix:
+		for _, contextValue := range contextValues {
+			ok, err := policyEvaluationValueMatches(operator, negated, contextValue, values)
+
+			if err != nil || ok {
+				return ok, err
+			}
+		}
+
+		return false, nil
+	default:
+		return policyEvaluationValueMatches(operator, negated, contextValues[0], values)
+	}
+}
+
+// policyEvaluationValueMatches returns whether a context value matches any of the condition values or,
+// for negated operators, none of them.
+func policyEvaluationValueMatches(operator string, negated bool, contextValue string, values []string) (bool, error) {
+	for _, value := range values {
+		ok, err := policyEvaluationCompare(operator, contextValue, value)
+
+		if err != nil {
+			return false, err
+		}
+
+		if ok {
+			return !negated, nil
+		}
+	}
+
+	return negated, nil
+}
+
+// policyEvaluationCompare compares a context value to a condition value using the positive form of an operator,
+// e.g. "stringequals" for both StringEquals and StringNotEquals.
+func policyEvaluationCompare(operator, contextValue, value string) (bool, error) {
+	switch operator {
+	case "stringequals", "stringnotequals":
+		return contextValue == value, nil
+	case "stringequalsignorecase", "stringnotequalsignorecase":
+		return strings.EqualFold(contextValue, value), nil
+	case "stringlike", "stringnotlike":
+		return policyEvaluationGlob(value, contextValue), nil
+	case "numericequals", "numericnotequals", "numericlessthan", "numericlessthanequals", "numericgreaterthan", "numericgreaterthanequals":
+		x, err := strconv.ParseFloat(contextValue, 64)
+		if err != nil {
+			return false, nil
+		}
+
+		y, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return false, fmt.Errorf("invalid numeric condition value: %s", value)
+		}
+
+		return policyEvaluationCompareOrdered(operator, x, y), nil
+	case "dateequals", "datenotequals", "datelessthan", "datelessthanequals", "dategreaterthan", "dategreaterthanequals":
+		x, err := policyEvaluationParseDate(contextValue)
+		if err != nil {
+			return false, nil
+		}
+
+		y, err := policyEvaluationParseDate(value)
+		if err != nil {
+			return false, fmt.Errorf("invalid date condition value: %s", value)
+		}
+
+		return policyEvaluationCompareOrdered(operator, float64(x.UnixNano()), float64(y.UnixNano())), nil
+	case "bool":
+		return strings.EqualFold(contextValue, value), nil
+	case "binaryequals":
+		x, err := base64.StdEncoding.DecodeString(contextValue)
+		if err != nil {
+			return false, nil
+		}
+
+		y, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			return false, fmt.Errorf("invalid binary condition value: %s", value)
+		}
+
+		return string(x) == string(y), nil
+	case "ipaddress", "notipaddress":
+		ip := net.ParseIP(contextValue)
+		if ip == nil {
+			return false, nil
+		}
+
+		if !strings.Contains(value, "/") {
+			return ip.Equal(net.ParseIP(value)), nil
+		}
+
+		_, ipNet, err := net.ParseCIDR(value)
+		if err != nil {
+			return false, fmt.Errorf("invalid IP address condition value: %s", value)
+		}
+
+		return ipNet.Contains(ip), nil
+	case "arnequals", "arnnotequals", "arnlike", "arnnotlike":
+		return policyEvaluationArnMatches(value, contextValue), nil
+	default:
+		return false, fmt.Errorf("unsupported condition operator: %s", operator)
+	}
+}
+
+func policyEvaluationCompareOrdered(operator string, x, y float64) bool {
+	switch {
+	case strings.HasSuffix(operator, "lessthanequals"):
+		return x <= y
+	case strings.HasSuffix(operator, "lessthan"):
+		return x < y
+	case strings.HasSuffix(operator, "greaterthanequals"):
+		return x >= y
+	case strings.HasSuffix(operator, "greaterthan"):
+		return x > y
+	default:
+		return x == y
+	}
+}
+
+func policyEvaluationParseDate(v string) (time.Time, error) {
+	if epoch, err := strconv.ParseInt(v, 10, 64); err == nil {
+		return time.Unix(epoch, 0), nil
+	}
+
+	return time.Parse(time.RFC3339, v)
+}
+
+// policyEvaluationArnMatches matches an ARN against a pattern segment by segment, as the Arn condition operators do.
+func policyEvaluationArnMatches(pattern, v string) bool {
+	patternSegments := strings.SplitN(pattern, ":", 6)
+	segments := strings.SplitN(v, ":", 6)
+
+	if len(patternSegments) != 6 || len(segments) != 6 {
+		return false
+	}
+
+	for i := range segments {
+		if !policyEvaluationGlob(patternSegments[i], segments[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// policyEvaluationGlob matches a value against a pattern in which "*" matches any sequence of characters,
+// including "/", "?" matches any single character and a backslash escapes the following character.
+// Patterns produced by policyEvaluationSubstituteVariables escape literal characters this way.
+func policyEvaluationGlob(pattern, v string) bool {
+	if !strings.ContainsAny(pattern, `*?\`) {
+		return pattern == v
+	}
+
+	var expr strings.Builder
+
+	expr.WriteString("^")
+	escaped := false
+	for _, r := range pattern {
+		switch {
+		case escaped:
+			expr.WriteString(regexp.QuoteMeta(string(r)))
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '*':
+			expr.WriteString(".*")
+		case r == '?':
+			expr.WriteString(".")
+		default:
+			expr.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	if escaped {
+		expr.WriteString(regexp.QuoteMeta(`\`))
+	}
+	expr.WriteString("$")
+
+	ok, _ := regexp.MatchString(expr.String(), v)
+
+	return ok
+}
+
+var (
+	policyEvaluationVariableRegexp = regexp.MustCompile(`\$\{([^}]+)\}`)
+
+	policyEvaluationGlobEscaper = strings.NewReplacer(`\`, `\\`, `*`, `\*`, `?`, `\?`)
+)
+
+// policyEvaluationSubstituteVariables replaces the policy variables in a value, e.g. "${aws:username}",
+// with their values from the request context. It returns false if a variable without a default has no value,
+// in which case the value matches nothing.
+// If glob is set the result is a policyEvaluationGlob pattern: "*" and "?" in the value remain wildcards, while
+// substituted values, including the literal "*" and "?" of "${*}" and "${?}", are escaped.
+func policyEvaluationSubstituteVariables(v string, request *policyEvaluationRequest, glob bool) (string, bool) {
+	var result strings.Builder
+	last := 0
+
+	for _, loc := range policyEvaluationVariableRegexp.FindAllStringIndex(v, -1) {
+		value, ok := policyEvaluationVariableValue(v[loc[0]+2:loc[1]-1], request)
+
+		if !ok {
+			return "", false
+		}
+
+		literal := v[last:loc[0]]
+
+		if glob {
+			literal = strings.ReplaceAll(literal, `\`, `\\`)
+			value = policyEvaluationGlobEscaper.Replace(value)
+		}
+
+		result.WriteString(literal)
+		result.WriteString(value)
+		last = loc[1]
+	}
+
+	literal := v[last:]
+
+	if glob {
+		literal = strings.ReplaceAll(literal, `\`, `\\`)
+	}
+
+	result.WriteString(literal)
+
+	return result.String(), true
+}
+
+// policyEvaluationVariableValue returns the value of a policy variable, e.g. "aws:username" or "aws:username, 'default'".
+func policyEvaluationVariableValue(name string, request *policyEvaluationRequest) (string, bool) {
+	switch name {
+	case "*", "?", "$":
+		return name, true
+	}
+
+	var defaultValue *string
+
+	if i := strings.Index(name, ","); i >= 0 {
+		// ${aws:username, 'default'}
+		value := strings.Trim(strings.TrimSpace(name[i+1:]), "'")
+		defaultValue = &value
+		name = strings.TrimSpace(name[:i])
+	}
+
+	if values := request.Context[strings.ToLower(name)]; len(values) > 0 {
+		return values[0], true
+	}
+
+	if defaultValue != nil {
+		return *defaultValue, true
+	}
+
+	return "", false
+}
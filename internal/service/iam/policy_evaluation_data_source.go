@@ -0,0 +1,271 @@
+package iam
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourcePolicyEvaluation() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePolicyEvaluationRead,
+
+		Schema: map[string]*schema.Schema{
+			"action": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"allowed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"context": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"values": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"decision": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deciding_statement": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"effect": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"index": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"policy": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"identity_policies": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidIAMPolicyJSON,
+				},
+			},
+			"permissions_boundary": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidIAMPolicyJSON,
+			},
+			"principal_arn": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"resource_arn": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "*",
+			},
+			"resource_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidIAMPolicyJSON,
+			},
+			"service_control_policies": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidIAMPolicyJSON,
+				},
+			},
+			"session_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidIAMPolicyJSON,
+			},
+		},
+	}
+}
+
+func dataSourcePolicyEvaluationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	request := &policyEvaluationRequest{
+		Action:            d.Get("action").(string),
+		PrincipalARN:      d.Get("principal_arn").(string),
+		ResourceARN:       d.Get("resource_arn").(string),
+		ResourceAccountID: d.Get("resource_account_id").(string),
+		Context:           make(map[string][]string),
+	}
+
+	if v, ok := d.GetOk("context"); ok && v.(*schema.Set).Len() > 0 {
+		for _, tfMapRaw := range v.(*schema.Set).List() {
+			tfMap := tfMapRaw.(map[string]interface{})
+			key := strings.ToLower(tfMap["key"].(string))
+
+			for _, value := range tfMap["values"].([]interface{}) {
+				v, _ := value.(string)
+				request.Context[key] = append(request.Context[key], v)
+			}
+		}
+	}
+
+	// Condition keys derived from the request, unless given in the context.
+	derived := map[string]string{
+		"aws:principalarn": request.PrincipalARN,
+	}
+
+	if v, err := arn.Parse(request.PrincipalARN); err == nil {
+		derived["aws:principalaccount"] = v.AccountID
+	}
+
+	if request.ResourceAccountID != "" {
+		derived["aws:resourceaccount"] = request.ResourceAccountID
+	} else if v, err := arn.Parse(request.ResourceARN); err == nil && v.AccountID != "" {
+		derived["aws:resourceaccount"] = v.AccountID
+	}
+
+	for key, value := range derived {
+		if _, ok := request.Context[key]; !ok && value != "" {
+			request.Context[key] = []string{value}
+		}
+	}
+
+	var policies policyEvaluationPolicies
+	var diags diag.Diagnostics
+
+	for i, v := range d.Get("identity_policies").([]interface{}) {
+		policy, err := expandPolicyEvaluationPolicy(fmt.Sprintf("identity_policies[%d]", i), v)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		policies.Identity = append(policies.Identity, *policy)
+	}
+
+	for i, v := range d.Get("service_control_policies").([]interface{}) {
+		policy, err := expandPolicyEvaluationPolicy(fmt.Sprintf("service_control_policies[%d]", i), v)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		policies.ServiceControlPolicies = append(policies.ServiceControlPolicies, *policy)
+	}
+
+	for _, v := range []struct {
+		key    string
+		policy **policyEvaluationPolicy
+	}{
+		{"permissions_boundary", &policies.PermissionsBoundary},
+		{"resource_policy", &policies.Resource},
+		{"session_policy", &policies.Session},
+	} {
+		if raw, ok := d.GetOk(v.key); ok {
+			policy, err := expandPolicyEvaluationPolicy(v.key, raw)
+
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			*v.policy = policy
+		}
+	}
+
+	if len(policies.Identity) == 0 && policies.Resource == nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "No identity-based or resource-based policies",
+			Detail:   "Without identity_policies or resource_policy, every request is implicitly denied.",
+		})
+	}
+
+	result, err := evaluatePolicies(policies, request)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error evaluating IAM policies: %w", err))
+	}
+
+	d.Set("allowed", result.Decision == policyEvaluationDecisionAllowed)
+	d.Set("decision", result.Decision)
+	d.Set("reason", result.Reason)
+
+	if err := d.Set("deciding_statement", flattenPolicyEvaluationStatement(result.Statement)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting deciding_statement: %w", err))
+	}
+
+	id, err := json.Marshal(request)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(string(id) + result.Reason)))
+
+	return diags
+}
+
+func expandPolicyEvaluationPolicy(name string, v interface{}) (*policyEvaluationPolicy, error) {
+	doc := &IAMPolicyDoc{}
+
+	if err := json.Unmarshal([]byte(v.(string)), doc); err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", name, err)
+	}
+
+	return &policyEvaluationPolicy{
+		Name: name,
+		Doc:  doc,
+	}, nil
+}
+
+func flattenPolicyEvaluationStatement(apiObject *policyEvaluationStatement) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"effect": apiObject.Effect,
+			"index":  apiObject.Index,
+			"policy": apiObject.Policy,
+			"sid":    apiObject.Sid,
+		},
+	}
+}
@@ -0,0 +1,127 @@
+package iam_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccIAMPolicyEvaluationDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_iam_policy_evaluation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyEvaluationDataSourceConfig("kms:Decrypt"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "allowed", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "decision", "allowed"),
+					resource.TestCheckResourceAttr(dataSourceName, "deciding_statement.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "deciding_statement.0.policy", "identity_policies[0]"),
+					resource.TestCheckResourceAttr(dataSourceName, "deciding_statement.0.sid", "KMS"),
+				),
+			},
+			{
+				Config: testAccPolicyEvaluationDataSourceConfig("kms:ScheduleKeyDeletion"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "allowed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "decision", "explicitDeny"),
+					resource.TestCheckResourceAttr(dataSourceName, "deciding_statement.0.policy", "identity_policies[0]"),
+					resource.TestCheckResourceAttr(dataSourceName, "deciding_statement.0.sid", "DenyDelete"),
+				),
+			},
+			{
+				Config: testAccPolicyEvaluationDataSourceConfig("s3:GetObject"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "allowed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "decision", "implicitDeny"),
+					resource.TestCheckResourceAttr(dataSourceName, "deciding_statement.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPolicyEvaluationDataSource_boundary(t *testing.T) {
+	dataSourceName := "data.aws_iam_policy_evaluation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyEvaluationDataSourceBoundaryConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "allowed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "decision", "implicitDeny"),
+					resource.TestMatchResourceAttr(dataSourceName, "reason", regexp.MustCompile(`permissions_boundary`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccPolicyEvaluationDataSourceConfig(action string) string {
+	return `
+data "aws_iam_policy_document" "test" {
+  statement {
+    sid       = "KMS"
+    actions   = ["kms:*"]
+    resources = ["*"]
+  }
+
+  statement {
+    sid       = "DenyDelete"
+    effect    = "Deny"
+    actions   = ["kms:ScheduleKeyDeletion", "kms:Disable*"]
+    resources = ["*"]
+  }
+}
+
+data "aws_iam_policy_evaluation" "test" {
+  action            = "` + action + `"
+  identity_policies = [data.aws_iam_policy_document.test.json]
+}
+`
+}
+
+const testAccPolicyEvaluationDataSourceBoundaryConfig = `
+data "aws_partition" "current" {}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_iam_policy_document" "identity" {
+  statement {
+    actions   = ["s3:*"]
+    resources = ["*"]
+  }
+}
+
+data "aws_iam_policy_document" "boundary" {
+  statement {
+    actions   = ["s3:GetObject"]
+    resources = ["arn:${data.aws_partition.current.partition}:s3:::example/*"]
+
+    condition {
+      test     = "StringEquals"
+      variable = "aws:PrincipalAccount"
+      values   = [data.aws_caller_identity.current.account_id]
+    }
+  }
+}
+
+data "aws_iam_policy_evaluation" "test" {
+  action               = "s3:PutObject"
+  principal_arn        = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:role/example"
+  resource_arn         = "arn:${data.aws_partition.current.partition}:s3:::example/key"
+  identity_policies    = [data.aws_iam_policy_document.identity.json]
+  permissions_boundary = data.aws_iam_policy_document.boundary.json
+}
+`
@@ -0,0 +1,542 @@
+package iam
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+func testPolicyEvaluationPolicy(t *testing.T, name, v string) *policyEvaluationPolicy {
+	t.Helper()
+
+	doc := &IAMPolicyDoc{}
+
+	if err := json.Unmarshal([]byte(v), doc); err != nil {
+		t.Fatalf("error parsing %s: %s", name, err)
+	}
+
+	return &policyEvaluationPolicy{Name: name, Doc: doc}
+}
+
+func TestEvaluatePolicies(t *testing.T) {
+	const (
+		allowAll = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*"}]}`
+		allowKMS = `{"Version":"2012-10-17","Statement":[{"Sid":"KMS","Effect":"Allow","Action":"kms:*","Resource":"*"}]}`
+		denyKMS  = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {"Effect": "Allow", "Action": "kms:*", "Resource": "*"},
+    {"Sid": "DenyDelete", "Effect": "Deny", "Action": ["kms:ScheduleKeyDeletion", "kms:Disable*"], "Resource": "*"}
+  ]
+}`
+		allowS3Bucket = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::example/*"}]}` //lintignore:AWSAT005
+		bucketPolicy  = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {"Effect": "Allow", "Principal": {"AWS": "arn:aws:iam::111111111111:root"}, "Action": "s3:GetObject", "Resource": "arn:aws:s3:::example/*"}
+  ]
+}` //lintignore:AWSAT005
+		roleBucketPolicy = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {"Effect": "Allow", "Principal": {"AWS": "arn:aws:iam::123456789012:role/example"}, "Action": "s3:GetObject", "Resource": "arn:aws:s3:::example/*"}
+  ]
+}` //lintignore:AWSAT005
+		sessionBucketPolicy = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {"Effect": "Allow", "Principal": {"AWS": "arn:aws:sts::123456789012:assumed-role/example/session"}, "Action": "s3:GetObject", "Resource": "arn:aws:s3:::example/*"}
+  ]
+}` //lintignore:AWSAT005
+		conditionPolicy = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": "s3:GetObject",
+      "Resource": "arn:aws:s3:::example/home/${aws:username}/*",
+      "Condition": {
+        "StringEquals": {"aws:PrincipalTag/team": ["blue", "green"]},
+        "IpAddress": {"aws:SourceIp": "10.0.0.0/8"},
+        "Bool": {"aws:SecureTransport": true},
+        "NumericLessThanEquals": {"s3:max-keys": "100"},
+        "DateGreaterThan": {"aws:CurrentTime": "2020-01-01T00:00:00Z"}
+      }
+    }
+  ]
+}` //lintignore:AWSAT005
+		scpRegion = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {"Effect": "Allow", "Action": "*", "Resource": "*"},
+    {"Effect": "Deny", "Action": "*", "Resource": "*", "Condition": {"StringNotEquals": {"aws:RequestedRegion": ["us-west-2"]}}}
+  ]
+}`
+		kmsDefaultKeyPolicy = `{
+  "Version": "2012-10-17",
+  "Id": "key-default-1",
+  "Statement": [
+    {"Sid": "Enable IAM User Permissions", "Effect": "Allow", "Principal": {"AWS": "arn:aws:iam::123456789012:root"}, "Action": "kms:*", "Resource": "*"}
+  ]
+}` //lintignore:AWSAT005
+		literalWildcard = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::example/${*}${?}"}]}` //lintignore:AWSAT005
+		scpS3Only       = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`
+		notAction       = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","NotAction":"iam:*","Resource":"*"}]}`
+	)
+
+	testCases := []struct {
+		Name              string
+		Identity          []string
+		Resource          string
+		Boundary          string
+		Session           string
+		SCPs              []string
+		Request           *policyEvaluationRequest
+		ExpectedDecision  string
+		ExpectedStatement *policyEvaluationStatement
+	}{
+		{
+			Name:             "no policies",
+			Request:          &policyEvaluationRequest{Action: "s3:GetObject", ResourceARN: "*"},
+			ExpectedDecision: policyEvaluationDecisionImplicitDeny,
+		},
+		{
+			Name:              "identity allow",
+			Identity:          []string{allowKMS},
+			Request:           &policyEvaluationRequest{Action: "kms:Decrypt", ResourceARN: "*"},
+			ExpectedDecision:  policyEvaluationDecisionAllowed,
+			ExpectedStatement: &policyEvaluationStatement{Policy: "identity_policies[0]", Index: 0, Sid: "KMS", Effect: "Allow"},
+		},
+		{
+			Name:             "action case insensitive",
+			Identity:         []string{allowKMS},
+			Request:          &policyEvaluationRequest{Action: "KMS:decrypt", ResourceARN: "*"},
+			ExpectedDecision: policyEvaluationDecisionAllowed,
+		},
+		{
+			Name:              "explicit deny",
+			Identity:          []string{allowAll, denyKMS},
+			Request:           &policyEvaluationRequest{Action: "kms:ScheduleKeyDeletion", ResourceARN: "*"},
+			ExpectedDecision:  policyEvaluationDecisionExplicitDeny,
+			ExpectedStatement: &policyEvaluationStatement{Policy: "identity_policies[1]", Index: 1, Sid: "DenyDelete", Effect: "Deny"},
+		},
+		{
+			Name:             "explicit deny wildcard",
+			Identity:         []string{denyKMS},
+			Request:          &policyEvaluationRequest{Action: "kms:DisableKey", ResourceARN: "*"},
+			ExpectedDecision: policyEvaluationDecisionExplicitDeny,
+		},
+		{
+			Name:             "resource mismatch",
+			Identity:         []string{allowS3Bucket},
+			Request:          &policyEvaluationRequest{Action: "s3:GetObject", ResourceARN: "arn:aws:s3:::other/key"}, //lintignore:AWSAT005
+			ExpectedDecision: policyEvaluationDecisionImplicitDeny,
+		},
+		{
+			Name:             "not action",
+			Identity:         []string{notAction},
+			Request:          &policyEvaluationRequest{Action: "iam:CreateUser", ResourceARN: "*"},
+			ExpectedDecision: policyEvaluationDecisionImplicitDeny,
+		},
+		{
+			Name:     "same-account resource policy",
+			Resource: roleBucketPolicy,
+			Request: &policyEvaluationRequest{
+				Action:            "s3:GetObject",
+				PrincipalARN:      "arn:aws:sts::123456789012:assumed-role/example/session", //lintignore:AWSAT005
+				ResourceARN:       "arn:aws:s3:::example/key",                               //lintignore:AWSAT005
+				ResourceAccountID: "123456789012",
+			},
+			ExpectedDecision:  policyEvaluationDecisionAllowed,
+			ExpectedStatement: &policyEvaluationStatement{Policy: "resource_policy", Index: 0, Effect: "Allow"},
+		},
+		{
+			Name:     "same-account resource policy role principal with permissions boundary",
+			Resource: roleBucketPolicy,
+			Boundary: allowKMS,
+			Request: &policyEvaluationRequest{
+				Action:            "s3:GetObject",
+				PrincipalARN:      "arn:aws:sts::123456789012:assumed-role/example/session", //lintignore:AWSAT005
+				ResourceARN:       "arn:aws:s3:::example/key",                               //lintignore:AWSAT005
+				ResourceAccountID: "123456789012",
+			},
+			ExpectedDecision: policyEvaluationDecisionImplicitDeny,
+		},
+		{
+			Name:     "same-account resource policy role principal with session policy",
+			Resource: roleBucketPolicy,
+			Session:  allowKMS,
+			Request: &policyEvaluationRequest{
+				Action:            "s3:GetObject",
+				PrincipalARN:      "arn:aws:sts::123456789012:assumed-role/example/session", //lintignore:AWSAT005
+				ResourceARN:       "arn:aws:s3:::example/key",                               //lintignore:AWSAT005
+				ResourceAccountID: "123456789012",
+			},
+			ExpectedDecision: policyEvaluationDecisionImplicitDeny,
+		},
+		{
+			Name:     "same-account resource policy session principal with permissions boundary",
+			Resource: sessionBucketPolicy,
+			Boundary: allowKMS,
+			Request: &policyEvaluationRequest{
+				Action:            "s3:GetObject",
+				PrincipalARN:      "arn:aws:sts::123456789012:assumed-role/example/session", //lintignore:AWSAT005
+				ResourceARN:       "arn:aws:s3:::example/key",                               //lintignore:AWSAT005
+				ResourceAccountID: "123456789012",
+			},
+			ExpectedDecision:  policyEvaluationDecisionAllowed,
+			ExpectedStatement: &policyEvaluationStatement{Policy: "resource_policy", Index: 0, Effect: "Allow"},
+		},
+		{
+			Name:     "same-account account principal without identity policy",
+			Resource: kmsDefaultKeyPolicy,
+			Request: &policyEvaluationRequest{
+				Action:            "kms:ScheduleKeyDeletion",
+				PrincipalARN:      "arn:aws:iam::123456789012:role/example",                                      //lintignore:AWSAT005
+				ResourceARN:       "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab", //lintignore:AWSAT003,AWSAT005
+				ResourceAccountID: "123456789012",
+			},
+			ExpectedDecision: policyEvaluationDecisionImplicitDeny,
+		},
+		{
+			Name:     "same-account account principal with identity policy",
+			Identity: []string{allowKMS},
+			Resource: kmsDefaultKeyPolicy,
+			Request: &policyEvaluationRequest{
+				Action:            "kms:ScheduleKeyDeletion",
+				PrincipalARN:      "arn:aws:iam::123456789012:role/example",                                      //lintignore:AWSAT005
+				ResourceARN:       "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab", //lintignore:AWSAT003,AWSAT005
+				ResourceAccountID: "123456789012",
+			},
+			ExpectedDecision:  policyEvaluationDecisionAllowed,
+			ExpectedStatement: &policyEvaluationStatement{Policy: "identity_policies[0]", Index: 0, Sid: "KMS", Effect: "Allow"},
+		},
+		{
+			Name:     "same-account account principal with permissions boundary",
+			Identity: []string{allowKMS},
+			Resource: kmsDefaultKeyPolicy,
+			Boundary: scpS3Only,
+			Request: &policyEvaluationRequest{
+				Action:            "kms:ScheduleKeyDeletion",
+				PrincipalARN:      "arn:aws:iam::123456789012:role/example",                                      //lintignore:AWSAT005
+				ResourceARN:       "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab", //lintignore:AWSAT003,AWSAT005
+				ResourceAccountID: "123456789012",
+			},
+			ExpectedDecision: policyEvaluationDecisionImplicitDeny,
+		},
+		{
+			Name:     "cross-account without identity policy",
+			Resource: bucketPolicy,
+			Request: &policyEvaluationRequest{
+				Action:            "s3:GetObject",
+				PrincipalARN:      "arn:aws:iam::111111111111:role/example", //lintignore:AWSAT005
+				ResourceARN:       "arn:aws:s3:::example/key",               //lintignore:AWSAT005
+				ResourceAccountID: "123456789012",
+			},
+			ExpectedDecision: policyEvaluationDecisionImplicitDeny,
+		},
+		{
+			Name:     "cross-account without resource policy",
+			Identity: []string{allowS3Bucket},
+			Request: &policyEvaluationRequest{
+				Action:            "s3:GetObject",
+				PrincipalARN:      "arn:aws:iam::111111111111:role/example", //lintignore:AWSAT005
+				ResourceARN:       "arn:aws:s3:::example/key",               //lintignore:AWSAT005
+				ResourceAccountID: "123456789012",
+			},
+			ExpectedDecision: policyEvaluationDecisionImplicitDeny,
+		},
+		{
+			Name:     "cross-account",
+			Identity: []string{allowS3Bucket},
+			Resource: bucketPolicy,
+			Request: &policyEvaluationRequest{
+				Action:            "s3:GetObject",
+				PrincipalARN:      "arn:aws:iam::111111111111:role/example", //lintignore:AWSAT005
+				ResourceARN:       "arn:aws:s3:::example/key",               //lintignore:AWSAT005
+				ResourceAccountID: "123456789012",
+			},
+			ExpectedDecision:  policyEvaluationDecisionAllowed,
+			ExpectedStatement: &policyEvaluationStatement{Policy: "identity_policies[0]", Index: 0, Effect: "Allow"},
+		},
+		{
+			Name:             "permissions boundary",
+			Identity:         []string{allowAll},
+			Boundary:         scpS3Only,
+			Request:          &policyEvaluationRequest{Action: "kms:Decrypt", ResourceARN: "*"},
+			ExpectedDecision: policyEvaluationDecisionImplicitDeny,
+		},
+		{
+			Name:             "session policy",
+			Identity:         []string{allowAll},
+			Session:          allowKMS,
+			Request:          &policyEvaluationRequest{Action: "kms:Decrypt", ResourceARN: "*"},
+			ExpectedDecision: policyEvaluationDecisionAllowed,
+		},
+		{
+			Name:             "service control policy not allowed",
+			Identity:         []string{allowAll},
+			SCPs:             []string{allowAll, scpS3Only},
+			Request:          &policyEvaluationRequest{Action: "kms:Decrypt", ResourceARN: "*"},
+			ExpectedDecision: policyEvaluationDecisionImplicitDeny,
+		},
+		{
+			Name:     "service control policy deny",
+			Identity: []string{allowAll},
+			SCPs:     []string{scpRegion},
+			Request: &policyEvaluationRequest{
+				Action:      "kms:Decrypt",
+				ResourceARN: "*",
+				Context:     map[string][]string{"aws:requestedregion": {"eu-west-1"}},
+			},
+			ExpectedDecision: policyEvaluationDecisionExplicitDeny,
+		},
+		{
+			Name:             "service control policy condition key missing",
+			Identity:         []string{allowAll},
+			SCPs:             []string{scpRegion},
+			Request:          &policyEvaluationRequest{Action: "kms:Decrypt", ResourceARN: "*"},
+			ExpectedDecision: policyEvaluationDecisionExplicitDeny,
+		},
+		{
+			Name:     "conditions",
+			Identity: []string{conditionPolicy},
+			Request: &policyEvaluationRequest{
+				Action:      "s3:GetObject",
+				ResourceARN: "arn:aws:s3:::example/home/jdoe/key", //lintignore:AWSAT005
+				Context: map[string][]string{
+					"aws:username":          {"jdoe"},
+					"aws:principaltag/team": {"green"},
+					"aws:sourceip":          {"10.1.2.3"},
+					"aws:securetransport":   {"true"},
+					"s3:max-keys":           {"10"},
+					"aws:currenttime":       {"2022-06-01T00:00:00Z"},
+				},
+			},
+			ExpectedDecision: policyEvaluationDecisionAllowed,
+		},
+		{
+			Name:     "conditions not matched",
+			Identity: []string{conditionPolicy},
+			Request: &policyEvaluationRequest{
+				Action:      "s3:GetObject",
+				ResourceARN: "arn:aws:s3:::example/home/jdoe/key", //lintignore:AWSAT005
+				Context: map[string][]string{
+					"aws:username":          {"jdoe"},
+					"aws:principaltag/team": {"green"},
+					"aws:sourceip":          {"192.168.0.1"},
+					"aws:securetransport":   {"true"},
+					"s3:max-keys":           {"10"},
+					"aws:currenttime":       {"2022-06-01T00:00:00Z"},
+				},
+			},
+			ExpectedDecision: policyEvaluationDecisionImplicitDeny,
+		},
+		{
+			Name:             "literal wildcard variables",
+			Identity:         []string{literalWildcard},
+			Request:          &policyEvaluationRequest{Action: "s3:GetObject", ResourceARN: "arn:aws:s3:::example/*?"}, //lintignore:AWSAT005
+			ExpectedDecision: policyEvaluationDecisionAllowed,
+		},
+		{
+			Name:             "literal wildcard variables not wildcards",
+			Identity:         []string{literalWildcard},
+			Request:          &policyEvaluationRequest{Action: "s3:GetObject", ResourceARN: "arn:aws:s3:::example/key1"}, //lintignore:AWSAT005
+			ExpectedDecision: policyEvaluationDecisionImplicitDeny,
+		},
+		{
+			Name:     "policy variable",
+			Identity: []string{conditionPolicy},
+			Request: &policyEvaluationRequest{
+				Action:      "s3:GetObject",
+				ResourceARN: "arn:aws:s3:::example/home/other/key", //lintignore:AWSAT005
+				Context: map[string][]string{
+					"aws:username":          {"jdoe"},
+					"aws:principaltag/team": {"green"},
+					"aws:sourceip":          {"10.1.2.3"},
+					"aws:securetransport":   {"true"},
+					"s3:max-keys":           {"10"},
+					"aws:currenttime":       {"2022-06-01T00:00:00Z"},
+				},
+			},
+			ExpectedDecision: policyEvaluationDecisionImplicitDeny,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			var policies policyEvaluationPolicies
+
+			for i, v := range testCase.Identity {
+				policies.Identity = append(policies.Identity, *testPolicyEvaluationPolicy(t, fmt.Sprintf("identity_policies[%d]", i), v))
+			}
+
+			for i, v := range testCase.SCPs {
+				policies.ServiceControlPolicies = append(policies.ServiceControlPolicies, *testPolicyEvaluationPolicy(t, fmt.Sprintf("service_control_policies[%d]", i), v))
+			}
+
+			if testCase.Resource != "" {
+				policies.Resource = testPolicyEvaluationPolicy(t, "resource_policy", testCase.Resource)
+			}
+
+			if testCase.Boundary != "" {
+				policies.PermissionsBoundary = testPolicyEvaluationPolicy(t, "permissions_boundary", testCase.Boundary)
+			}
+
+			if testCase.Session != "" {
+				policies.Session = testPolicyEvaluationPolicy(t, "session_policy", testCase.Session)
+			}
+
+			if testCase.Request.Context == nil {
+				testCase.Request.Context = make(map[string][]string)
+			}
+
+			got, err := evaluatePolicies(policies, testCase.Request)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got.Decision != testCase.ExpectedDecision {
+				t.Errorf("got decision %q (%s), expected %q", got.Decision, got.Reason, testCase.ExpectedDecision)
+			}
+
+			if testCase.ExpectedStatement != nil {
+				if got.Statement == nil || *got.Statement != *testCase.ExpectedStatement {
+					t.Errorf("got statement %+v, expected %+v", got.Statement, testCase.ExpectedStatement)
+				}
+			}
+		})
+	}
+}
+
+func TestPolicyEvaluationConditionMatches(t *testing.T) {
+	testCases := []struct {
+		Name      string
+		Condition IAMPolicyStatementCondition
+		Context   map[string][]string
+		Expected  bool
+	}{
+		{
+			Name:      "StringLike",
+			Condition: IAMPolicyStatementCondition{Test: "StringLike", Variable: "s3:prefix", Values: "home/*"},
+			Context:   map[string][]string{"s3:prefix": {"home/jdoe"}},
+			Expected:  true,
+		},
+		{
+			Name:      "StringEqualsIgnoreCase",
+			Condition: IAMPolicyStatementCondition{Test: "StringEqualsIgnoreCase", Variable: "aws:PrincipalTag/team", Values: "Blue"},
+			Context:   map[string][]string{"aws:principaltag/team": {"BLUE"}},
+			Expected:  true,
+		},
+		{
+			Name:      "StringNotEquals missing key",
+			Condition: IAMPolicyStatementCondition{Test: "StringNotEquals", Variable: "aws:SourceVpc", Values: "vpc-12345678"},
+			Expected:  true,
+		},
+		{
+			Name:      "StringEquals missing key",
+			Condition: IAMPolicyStatementCondition{Test: "StringEquals", Variable: "aws:SourceVpc", Values: "vpc-12345678"},
+			Expected:  false,
+		},
+		{
+			Name:      "StringEqualsIfExists missing key",
+			Condition: IAMPolicyStatementCondition{Test: "StringEqualsIfExists", Variable: "aws:SourceVpc", Values: "vpc-12345678"},
+			Expected:  true,
+		},
+		{
+			Name:      "Null true",
+			Condition: IAMPolicyStatementCondition{Test: "Null", Variable: "aws:TokenIssueTime", Values: "true"},
+			Expected:  true,
+		},
+		{
+			Name:      "Null false",
+			Condition: IAMPolicyStatementCondition{Test: "Null", Variable: "aws:TokenIssueTime", Values: "true"},
+			Context:   map[string][]string{"aws:tokenissuetime": {"2022-06-01T00:00:00Z"}},
+			Expected:  false,
+		},
+		{
+			Name:      "ForAllValues",
+			Condition: IAMPolicyStatementCondition{Test: "ForAllValues:StringEquals", Variable: "aws:TagKeys", Values: []string{"team", "env"}},
+			Context:   map[string][]string{"aws:tagkeys": {"team", "owner"}},
+			Expected:  false,
+		},
+		{
+			Name:      "ForAllValues missing key",
+			Condition: IAMPolicyStatementCondition{Test: "ForAllValues:StringEquals", Variable: "aws:TagKeys", Values: []string{"team", "env"}},
+			Expected:  true,
+		},
+		{
+			Name:      "ForAnyValue",
+			Condition: IAMPolicyStatementCondition{Test: "ForAnyValue:StringEquals", Variable: "aws:TagKeys", Values: []string{"team", "env"}},
+			Context:   map[string][]string{"aws:tagkeys": {"team", "owner"}},
+			Expected:  true,
+		},
+		{
+			Name:      "ArnLike",
+			Condition: IAMPolicyStatementCondition{Test: "ArnLike", Variable: "aws:SourceArn", Values: "arn:aws:sns:*:123456789012:*"}, //lintignore:AWSAT005
+			Context:   map[string][]string{"aws:sourcearn": {"arn:aws:sns:us-west-2:123456789012:example"}},                            //lintignore:AWSAT003,AWSAT005
+			Expected:  true,
+		},
+		{
+			Name:      "StringLike literal wildcard variable",
+			Condition: IAMPolicyStatementCondition{Test: "StringLike", Variable: "s3:prefix", Values: "home/${*}"},
+			Context:   map[string][]string{"s3:prefix": {"home/jdoe"}},
+			Expected:  false,
+		},
+		{
+			Name:      "StringLike substituted value is literal",
+			Condition: IAMPolicyStatementCondition{Test: "StringLike", Variable: "s3:prefix", Values: "home/${aws:username}/*"},
+			Context:   map[string][]string{"s3:prefix": {"home/jdoe/key"}, "aws:username": {"j?oe"}},
+			Expected:  false,
+		},
+		{
+			Name:      "StringEquals literal wildcard variable",
+			Condition: IAMPolicyStatementCondition{Test: "StringEquals", Variable: "s3:prefix", Values: "home/${*}"},
+			Context:   map[string][]string{"s3:prefix": {"home/*"}},
+			Expected:  true,
+		},
+		{
+			Name:      "NotIpAddress",
+			Condition: IAMPolicyStatementCondition{Test: "NotIpAddress", Variable: "aws:SourceIp", Values: []string{"10.0.0.0/8", "192.168.0.0/16"}},
+			Context:   map[string][]string{"aws:sourceip": {"192.168.1.1"}},
+			Expected:  false,
+		},
+		{
+			Name:      "NumericGreaterThan",
+			Condition: IAMPolicyStatementCondition{Test: "NumericGreaterThan", Variable: "s3:max-keys", Values: "10"},
+			Context:   map[string][]string{"s3:max-keys": {"11"}},
+			Expected:  true,
+		},
+		{
+			Name:      "DateLessThan epoch",
+			Condition: IAMPolicyStatementCondition{Test: "DateLessThan", Variable: "aws:CurrentTime", Values: "2020-01-01T00:00:00Z"},
+			Context:   map[string][]string{"aws:currenttime": {"1546300800"}},
+			Expected:  true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			request := &policyEvaluationRequest{Context: testCase.Context}
+
+			if request.Context == nil {
+				request.Context = make(map[string][]string)
+			}
+
+			got, err := policyEvaluationConditionMatches(testCase.Condition, request)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
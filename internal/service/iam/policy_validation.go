@@ -0,0 +1,329 @@
+package iam
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// policyConditionOperators are the IAM condition operators, without the ForAllValues: and ForAnyValue:
+// set operator prefixes and the IfExists suffix
+// (https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_elements_condition_operators.html).
+var policyConditionOperators = []string{
+	"ArnEquals",
+	"ArnLike",
+	"ArnNotEquals",
+	"ArnNotLike",
+	"BinaryEquals",
+	"Bool",
+	"DateEquals",
+	"DateGreaterThan",
+	"DateGreaterThanEquals",
+	"DateLessThan",
+	"DateLessThanEquals",
+	"DateNotEquals",
+	"IpAddress",
+	"NotIpAddress",
+	"Null",
+	"NumericEquals",
+	"NumericGreaterThan",
+	"NumericGreaterThanEquals",
+	"NumericLessThan",
+	"NumericLessThanEquals",
+	"NumericNotEquals",
+	"StringEquals",
+	"StringEqualsIgnoreCase",
+	"StringLike",
+	"StringNotEquals",
+	"StringNotEqualsIgnoreCase",
+	"StringNotLike",
+}
+
+const (
+	policyConditionForAllValuesPrefix = "ForAllValues:"
+	policyConditionForAnyValuePrefix  = "ForAnyValue:"
+	policyConditionIfExistsSuffix     = "IfExists"
+)
+
+// validatePolicyDocument checks the semantics of a policy document.
+// It returns errors for statements that IAM rejects, and warnings for likely mistakes.
+func validatePolicyDocument(doc *IAMPolicyDoc) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for i, stmt := range doc.Statements {
+		name := fmt.Sprintf("statement %d", i)
+		if stmt.Sid != "" {
+			name = fmt.Sprintf("statement %d (%s)", i, stmt.Sid)
+		}
+
+		for _, action := range policyStatementStrings(stmt.Actions) {
+			diags = append(diags, validatePolicyAction(name, "Action", action)...)
+		}
+
+		for _, action := range policyStatementStrings(stmt.NotActions) {
+			diags = append(diags, validatePolicyAction(name, "NotAction", action)...)
+		}
+
+		for _, condition := range stmt.Conditions {
+			diags = append(diags, validatePolicyCondition(name, condition)...)
+		}
+
+		diags = append(diags, validatePolicyWildcardResource(name, stmt)...)
+	}
+
+	return diags
+}
+
+func validatePolicyAction(name, element, action string) diag.Diagnostics {
+	if action == "*" {
+		return nil
+	}
+
+	parts := strings.Split(action, ":")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" || strings.ContainsAny(action, " \t\n") {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Invalid %s in %s", element, name),
+				Detail:   fmt.Sprintf("%q is not a valid action. Actions must have the form <service>:<action>, e.g. \"s3:GetObject\", or be \"*\".", action),
+			},
+		}
+	}
+
+	service, ok := policyCatalog[strings.ToLower(parts[0])]
+
+	if !ok {
+		return nil
+	}
+
+	if _, ok := policyCatalogAction(service, parts[1]); ok {
+		return nil
+	}
+
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Unknown %s in %s", element, name),
+			Detail:   fmt.Sprintf("%q does not match any action in the provider's catalog of %s actions. Check the action name in the Service Authorization Reference; the catalog may not include recently released actions.", action, parts[0]),
+		},
+	}
+}
+
+func validatePolicyCondition(name string, condition IAMPolicyStatementCondition) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !policyConditionOperatorValid(condition.Test) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Unknown condition operator in %s", name),
+			Detail:   fmt.Sprintf("%q is not a valid condition operator. Valid operators are %s, optionally prefixed with %q or %q and, except for Null, suffixed with %q.", condition.Test, strings.Join(policyConditionOperators, ", "), policyConditionForAllValuesPrefix, policyConditionForAnyValuePrefix, policyConditionIfExistsSuffix),
+		})
+	}
+
+	key := condition.Variable
+	prefix := ""
+
+	if i := strings.Index(key, ":"); i > 0 {
+		prefix = strings.ToLower(key[:i])
+	}
+
+	var known bool
+
+	switch {
+	case prefix == "":
+		known = false
+	case prefix == "aws":
+		known = policyConditionKeyMatches(key, policyGlobalConditionKeys)
+	default:
+		service, ok := policyCatalog[prefix]
+
+		if !ok || len(service.ConditionKeys) == 0 {
+			// Condition keys of other services and of identity providers, e.g. "accounts.google.com:aud", are not checked.
+			known = true
+		} else {
+			known = policyConditionKeyMatches(key, service.ConditionKeys)
+		}
+	}
+
+	if !known {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Unknown condition key in %s", name),
+			Detail:   fmt.Sprintf("%q is not a known condition key. A condition using an unknown key never matches, or always matches with an IfExists operator.", key),
+		})
+	}
+
+	return diags
+}
+
+// validatePolicyWildcardResource warns about identity-based policy statements allowing write actions on all resources.
+// Resource-based policies, which have principals, are not checked as "*" refers to the resource the policy is attached to.
+func validatePolicyWildcardResource(name string, stmt *IAMPolicyStatement) diag.Diagnostics {
+	if stmt.Effect != "Allow" || len(stmt.Principals) > 0 || len(stmt.NotPrincipals) > 0 {
+		return nil
+	}
+
+	var wildcard bool
+
+	for _, resource := range policyStatementStrings(stmt.Resources) {
+		if resource == "*" {
+			wildcard = true
+			break
+		}
+	}
+
+	if !wildcard {
+		return nil
+	}
+
+	var actions []string
+
+	for _, action := range policyStatementStrings(stmt.Actions) {
+		if policyWriteAction(action) {
+			actions = append(actions, action)
+		}
+	}
+
+	if len(actions) == 0 {
+		return nil
+	}
+
+	sort.Strings(actions)
+
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Write actions allowed on all resources in %s", name),
+			Detail:   fmt.Sprintf("Actions %s are allowed on all resources (\"*\"). Consider limiting the resources to those the actions need.", strings.Join(actions, ", ")),
+		},
+	}
+}
+
+// policyWriteAction returns whether an action, which may contain wildcards, includes actions that modify resources
+// and support resource-level permissions.
+func policyWriteAction(action string) bool {
+	if action == "*" {
+		return true
+	}
+
+	parts := strings.Split(action, ":")
+
+	if len(parts) != 2 {
+		return false
+	}
+
+	name := parts[1]
+
+	if !strings.ContainsAny(name, "*?") {
+		if service, ok := policyCatalog[strings.ToLower(parts[0])]; ok {
+			for _, v := range service.WildcardOnlyActions {
+				if strings.EqualFold(v, name) {
+					return false
+				}
+			}
+		}
+	}
+
+	for _, prefix := range policyReadOnlyActionPrefixes {
+		// A wildcard matching only the read-only actions starting with the prefix, e.g. "Get*", is read-only.
+		if len(name) >= len(prefix) && strings.EqualFold(name[:len(prefix)], prefix) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// policyCatalogAction returns the first action of a catalog service matching an action name, which may contain wildcards.
+// Action names are not case sensitive.
+func policyCatalogAction(service policyCatalogService, name string) (string, bool) {
+	pattern := strings.ToLower(name)
+
+	for _, action := range service.Actions {
+		if ok, _ := path.Match(pattern, strings.ToLower(action)); ok {
+			return action, true
+		}
+	}
+
+	return "", false
+}
+
+// policyConditionOperatorValid returns whether a condition operator is valid.
+// Condition operator names are not case sensitive.
+func policyConditionOperatorValid(test string) bool {
+	operator := test
+
+	for _, prefix := range []string{policyConditionForAllValuesPrefix, policyConditionForAnyValuePrefix} {
+		if len(operator) > len(prefix) && strings.EqualFold(operator[:len(prefix)], prefix) {
+			operator = operator[len(prefix):]
+			break
+		}
+	}
+
+	if len(operator) > len(policyConditionIfExistsSuffix) && strings.EqualFold(operator[len(operator)-len(policyConditionIfExistsSuffix):], policyConditionIfExistsSuffix) {
+		operator = operator[:len(operator)-len(policyConditionIfExistsSuffix)]
+
+		if strings.EqualFold(operator, "Null") {
+			return false
+		}
+	}
+
+	for _, v := range policyConditionOperators {
+		if strings.EqualFold(operator, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// policyDocumentDuplicateSids warns about Sids used by more than one statement of a policy document.
+// When the document is merged, only the last statement with the Sid is kept.
+func policyDocumentDuplicateSids(doc *IAMPolicyDoc, source string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	seen := make(map[string]bool)
+
+	for _, stmt := range doc.Statements {
+		if stmt.Sid == "" {
+			continue
+		}
+
+		if seen[stmt.Sid] {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Duplicate Sid (%s) in %s", stmt.Sid, source),
+				Detail:   "Only the last statement with the Sid is included in the policy document. Remove the Sid or ensure Sids are unique.",
+			})
+		}
+
+		seen[stmt.Sid] = true
+	}
+
+	return diags
+}
+
+// policyStatementStrings returns the strings of a statement element, which is either a string or a list of strings.
+func policyStatementStrings(v interface{}) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []interface{}:
+		result := make([]string, 0, len(v))
+
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				result = append(result, s)
+			}
+		}
+
+		return result
+	default:
+		return nil
+	}
+}
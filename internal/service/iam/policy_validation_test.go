@@ -0,0 +1,163 @@
+package iam
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func TestValidatePolicyDocument(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		Statement        *IAMPolicyStatement
+		ExpectedErrors   int
+		ExpectedWarnings int
+	}{
+		{
+			Name: "valid",
+			Statement: &IAMPolicyStatement{
+				Effect:    "Allow",
+				Actions:   []string{"s3:GetObject", "s3:List*", "ec2:DescribeInstances"},
+				Resources: "arn:aws:s3:::example/*", //lintignore:AWSAT005
+				Conditions: IAMPolicyStatementConditionSet{
+					{Test: "StringLike", Variable: "s3:prefix", Values: "home/"},
+					{Test: "ForAnyValue:StringEqualsIfExists", Variable: "aws:PrincipalTag/team", Values: "example"},
+					{Test: "StringEquals", Variable: "accounts.google.com:aud", Values: "example"},
+				},
+			},
+		},
+		{
+			Name: "malformed action",
+			Statement: &IAMPolicyStatement{
+				Effect:    "Allow",
+				Actions:   "s3GetObject",
+				Resources: "arn:aws:s3:::example/*", //lintignore:AWSAT005
+			},
+			ExpectedErrors: 1,
+		},
+		{
+			Name: "unknown action",
+			Statement: &IAMPolicyStatement{
+				Effect:    "Allow",
+				Actions:   []string{"s3:GetObjekt", "sqs:Send*", "kms:ReEncrypt*"},
+				Resources: "arn:aws:s3:::example/*", //lintignore:AWSAT005
+			},
+			ExpectedWarnings: 1,
+		},
+		{
+			Name: "iam and ec2 actions",
+			Statement: &IAMPolicyStatement{
+				Effect:    "Allow",
+				Actions:   []string{"iam:PassRole", "iam:GetRole", "ec2:RunInstances", "ec2:RunInstance", "iam:PassRoles"},
+				Resources: "arn:aws:iam::123456789012:role/example", //lintignore:AWSAT005
+				Conditions: IAMPolicyStatementConditionSet{
+					{Test: "StringEquals", Variable: "iam:PassedToService", Values: "ec2.amazonaws.com"},
+					{Test: "StringEquals", Variable: "iam:PassedToServices", Values: "ec2.amazonaws.com"},
+					{Test: "StringEquals", Variable: "aws:SourceOwner", Values: "123456789012"},
+					{Test: "StringEquals", Variable: "ec2:Region", Values: "us-west-2"}, //lintignore:AWSAT003
+				},
+			},
+			ExpectedWarnings: 3,
+		},
+		{
+			Name: "unknown condition operator",
+			Statement: &IAMPolicyStatement{
+				Effect:    "Allow",
+				Actions:   "s3:GetObject",
+				Resources: "arn:aws:s3:::example/*", //lintignore:AWSAT005
+				Conditions: IAMPolicyStatementConditionSet{
+					{Test: "StringEqual", Variable: "aws:SourceVpc", Values: "vpc-12345678"},
+					{Test: "NullIfExists", Variable: "aws:SourceVpc", Values: "false"},
+				},
+			},
+			ExpectedErrors: 2,
+		},
+		{
+			Name: "unknown condition key",
+			Statement: &IAMPolicyStatement{
+				Effect:    "Allow",
+				Actions:   "s3:GetObject",
+				Resources: "arn:aws:s3:::example/*", //lintignore:AWSAT005
+				Conditions: IAMPolicyStatementConditionSet{
+					{Test: "StringEquals", Variable: "aws:SourceVPC", Values: "vpc-12345678"},
+					{Test: "StringEquals", Variable: "aws:SourceVpcId", Values: "vpc-12345678"},
+					{Test: "StringEquals", Variable: "s3:x-amz-acls", Values: "private"},
+					{Test: "StringEquals", Variable: "SourceVpc", Values: "vpc-12345678"},
+				},
+			},
+			ExpectedWarnings: 3,
+		},
+		{
+			Name: "write actions on all resources",
+			Statement: &IAMPolicyStatement{
+				Effect:    "Allow",
+				Actions:   []string{"s3:GetObject", "s3:PutObject", "kms:CreateKey"},
+				Resources: "*",
+			},
+			ExpectedWarnings: 1,
+		},
+		{
+			Name: "read actions on all resources",
+			Statement: &IAMPolicyStatement{
+				Effect:    "Allow",
+				Actions:   []string{"s3:Get*", "ec2:Describe*", "kms:CreateKey", "iam:CreateAccountAlias"},
+				Resources: "*",
+			},
+		},
+		{
+			Name: "write actions on all resources in resource-based policy",
+			Statement: &IAMPolicyStatement{
+				Effect:     "Allow",
+				Actions:    "kms:*",
+				Resources:  "*",
+				Principals: IAMPolicyStatementPrincipalSet{{Type: "AWS", Identifiers: "arn:aws:iam::123456789012:root"}}, //lintignore:AWSAT005
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			diags := validatePolicyDocument(&IAMPolicyDoc{
+				Version:    "2012-10-17",
+				Statements: []*IAMPolicyStatement{testCase.Statement},
+			})
+
+			var errors, warnings int
+
+			for _, d := range diags {
+				switch d.Severity {
+				case diag.Error:
+					errors++
+				case diag.Warning:
+					warnings++
+				}
+			}
+
+			if errors != testCase.ExpectedErrors {
+				t.Errorf("got %d errors, expected %d: %v", errors, testCase.ExpectedErrors, diags)
+			}
+
+			if warnings != testCase.ExpectedWarnings {
+				t.Errorf("got %d warnings, expected %d: %v", warnings, testCase.ExpectedWarnings, diags)
+			}
+		})
+	}
+}
+
+func TestPolicyDocumentDuplicateSids(t *testing.T) {
+	doc := &IAMPolicyDoc{
+		Statements: []*IAMPolicyStatement{
+			{Sid: "One"},
+			{Sid: ""},
+			{Sid: "One"},
+			{Sid: ""},
+			{Sid: "Two"},
+		},
+	}
+
+	if got := policyDocumentDuplicateSids(doc, "override_json"); len(got) != 1 {
+		t.Errorf("got %d diagnostics, expected 1: %v", len(got), got)
+	}
+}
@@ -0,0 +1,217 @@
+package kms
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceCustomKeyStore() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCustomKeyStoreCreate,
+		Read:   resourceCustomKeyStoreRead,
+		Update: resourceCustomKeyStoreUpdate,
+		Delete: resourceCustomKeyStoreDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cloud_hsm_cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(19, 24),
+			},
+			"connection_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"custom_key_store_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"key_store_password": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(7, 32),
+			},
+			"trust_anchor_certificate": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceCustomKeyStoreCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KMSConn
+
+	name := d.Get("custom_key_store_name").(string)
+	input := &kms.CreateCustomKeyStoreInput{
+		CloudHsmClusterId:      aws.String(d.Get("cloud_hsm_cluster_id").(string)),
+		CustomKeyStoreName:     aws.String(name),
+		KeyStorePassword:       aws.String(d.Get("key_store_password").(string)),
+		TrustAnchorCertificate: aws.String(d.Get("trust_anchor_certificate").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating KMS Custom Key Store: %s", input)
+	output, err := conn.CreateCustomKeyStore(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating KMS Custom Key Store (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.CustomKeyStoreId))
+
+	return resourceCustomKeyStoreRead(d, meta)
+}
+
+func resourceCustomKeyStoreRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KMSConn
+
+	output, err := FindCustomKeyStoreByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] KMS Custom Key Store (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading KMS Custom Key Store (%s): %w", d.Id(), err)
+	}
+
+	d.Set("cloud_hsm_cluster_id", output.CloudHsmClusterId)
+	d.Set("connection_state", output.ConnectionState)
+	d.Set("custom_key_store_name", output.CustomKeyStoreName)
+	d.Set("trust_anchor_certificate", output.TrustAnchorCertificate)
+
+	return nil
+}
+
+func resourceCustomKeyStoreUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KMSConn
+
+	input := &kms.UpdateCustomKeyStoreInput{
+		CustomKeyStoreId: aws.String(d.Id()),
+	}
+
+	if d.HasChange("cloud_hsm_cluster_id") {
+		input.CloudHsmClusterId = aws.String(d.Get("cloud_hsm_cluster_id").(string))
+	}
+
+	if d.HasChange("custom_key_store_name") {
+		input.NewCustomKeyStoreName = aws.String(d.Get("custom_key_store_name").(string))
+	}
+
+	if d.HasChange("key_store_password") {
+		input.KeyStorePassword = aws.String(d.Get("key_store_password").(string))
+	}
+
+	// The cluster and password can only be changed while the key store is disconnected.
+	reconnect := false
+
+	if d.HasChanges("cloud_hsm_cluster_id", "key_store_password") && d.Get("connection_state").(string) == kms.ConnectionStateTypeConnected {
+		if err := disconnectCustomKeyStore(conn, d.Id()); err != nil {
+			return err
+		}
+
+		reconnect = true
+	}
+
+	log.Printf("[DEBUG] Updating KMS Custom Key Store: %s", input)
+	_, err := conn.UpdateCustomKeyStore(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating KMS Custom Key Store (%s): %w", d.Id(), err)
+	}
+
+	if reconnect {
+		if err := connectCustomKeyStore(conn, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	return resourceCustomKeyStoreRead(d, meta)
+}
+
+func resourceCustomKeyStoreDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KMSConn
+
+	output, err := FindCustomKeyStoreByID(conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading KMS Custom Key Store (%s): %w", d.Id(), err)
+	}
+
+	// A key store must be disconnected before it can be deleted.
+	if state := aws.StringValue(output.ConnectionState); state != kms.ConnectionStateTypeDisconnected {
+		if err := disconnectCustomKeyStore(conn, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting KMS Custom Key Store: %s", d.Id())
+	_, err = conn.DeleteCustomKeyStore(&kms.DeleteCustomKeyStoreInput{
+		CustomKeyStoreId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, kms.ErrCodeCustomKeyStoreNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting KMS Custom Key Store (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func connectCustomKeyStore(conn *kms.KMS, id string) error {
+	log.Printf("[DEBUG] Connecting KMS Custom Key Store: %s", id)
+	_, err := conn.ConnectCustomKeyStore(&kms.ConnectCustomKeyStoreInput{
+		CustomKeyStoreId: aws.String(id),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error connecting KMS Custom Key Store (%s): %w", id, err)
+	}
+
+	if _, err := WaitCustomKeyStoreConnected(conn, id); err != nil {
+		return fmt.Errorf("error waiting for KMS Custom Key Store (%s) connect: %w", id, err)
+	}
+
+	return nil
+}
+
+func disconnectCustomKeyStore(conn *kms.KMS, id string) error {
+	log.Printf("[DEBUG] Disconnecting KMS Custom Key Store: %s", id)
+	_, err := conn.DisconnectCustomKeyStore(&kms.DisconnectCustomKeyStoreInput{
+		CustomKeyStoreId: aws.String(id),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error disconnecting KMS Custom Key Store (%s): %w", id, err)
+	}
+
+	if _, err := WaitCustomKeyStoreDisconnected(conn, id); err != nil {
+		return fmt.Errorf("error waiting for KMS Custom Key Store (%s) disconnect: %w", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,152 @@
+package kms
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceKeyPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKeyPolicyCreate,
+		Read:   resourceKeyPolicyRead,
+		Update: resourceKeyPolicyUpdate,
+		Delete: resourceKeyPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bypass_policy_lockout_safety_check": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"key_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"policy": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+				ValidateFunc:     validation.StringIsJSON,
+			},
+		},
+	}
+}
+
+func resourceKeyPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KMSConn
+
+	keyID := d.Get("key_id").(string)
+
+	if err := updateKmsKeyPolicy(conn, keyID, d.Get("policy").(string), d.Get("bypass_policy_lockout_safety_check").(bool)); err != nil {
+		return err
+	}
+
+	d.SetId(keyID)
+
+	return resourceKeyPolicyRead(d, meta)
+}
+
+func resourceKeyPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KMSConn
+
+	_, err := FindKeyByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] KMS Key (%s) not found, removing KMS Key Policy from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading KMS Key (%s): %w", d.Id(), err)
+	}
+
+	policy, err := FindKeyPolicyByKeyIDAndPolicyName(conn, d.Id(), PolicyNameDefault)
+
+	if err != nil {
+		return fmt.Errorf("error reading KMS Key (%s) policy: %w", d.Id(), err)
+	}
+
+	policyToSet, err := verify.PolicyToSet(d.Get("policy").(string), aws.StringValue(policy))
+
+	if err != nil {
+		return err
+	}
+
+	d.Set("key_id", d.Id())
+	d.Set("policy", policyToSet)
+
+	return nil
+}
+
+func resourceKeyPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KMSConn
+
+	if d.HasChange("policy") {
+		if err := updateKmsKeyPolicy(conn, d.Id(), d.Get("policy").(string), d.Get("bypass_policy_lockout_safety_check").(bool)); err != nil {
+			return err
+		}
+	}
+
+	return resourceKeyPolicyRead(d, meta)
+}
+
+func resourceKeyPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KMSConn
+
+	key, err := FindKeyByID(conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading KMS Key (%s): %w", d.Id(), err)
+	}
+
+	// There is no API to remove a key policy, so restore the default policy that KMS
+	// attaches to keys created without one. The key may be owned by another account.
+	rootARN := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   "iam",
+		AccountID: aws.StringValue(key.AWSAccountId),
+		Resource:  "root",
+	}.String()
+	policy, err := structure.NormalizeJsonString(fmt.Sprintf(keyPolicyDefaultTemplate, rootARN))
+
+	if err != nil {
+		return err
+	}
+
+	return updateKmsKeyPolicy(conn, d.Id(), policy, d.Get("bypass_policy_lockout_safety_check").(bool))
+}
+
+const keyPolicyDefaultTemplate = `{
+  "Version": "2012-10-17",
+  "Id": "key-default-1",
+  "Statement": [
+    {
+      "Sid": "Enable IAM User Permissions",
+      "Effect": "Allow",
+      "Principal": {
+        "AWS": %[1]q
+      },
+      "Action": "kms:*",
+      "Resource": "*"
+    }
+  ]
+}`
@@ -0,0 +1,80 @@
+package kms_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kms"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccKMSKeyPolicy_basic(t *testing.T) {
+	var key kms.KeyMetadata
+	keyResourceName := "aws_kms_key.test"
+	resourceName := "aws_kms_key_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kms.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyPolicyConfig(rName, "Enable IAM User Permissions"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(keyResourceName, &key),
+					resource.TestCheckResourceAttrPair(resourceName, "key_id", keyResourceName, "key_id"),
+					resource.TestMatchResourceAttr(resourceName, "policy", regexp.MustCompile(`Enable IAM User Permissions`)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"bypass_policy_lockout_safety_check"},
+			},
+			{
+				Config: testAccKeyPolicyConfig(rName, "Enable IAM User Permissions Updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(keyResourceName, &key),
+					resource.TestMatchResourceAttr(resourceName, "policy", regexp.MustCompile(`Enable IAM User Permissions Updated`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccKeyPolicyConfig(rName, sid string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_kms_key_policy" "test" {
+  key_id = aws_kms_key.test.key_id
+
+  policy = jsonencode({
+    Id = %[1]q
+    Statement = [{
+      Sid    = %[2]q
+      Effect = "Allow"
+      Principal = {
+        AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+      }
+      Action   = "kms:*"
+      Resource = "*"
+    }]
+    Version = "2012-10-17"
+  })
+}
+`, rName, sid)
+}
@@ -7,6 +7,22 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+func StatusCustomKeyStoreConnectionState(conn *kms.KMS, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindCustomKeyStoreByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.ConnectionState), nil
+	}
+}
+
 func StatusKeyState(conn *kms.KMS, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindKeyByID(conn, id)
@@ -0,0 +1,114 @@
+// Code generated by "internal/generate/listdatasource/main.go -Name=Functions -ListOp=ListFunctions -ListOutElem=Functions -IDElem=FunctionArn -NameElem=FunctionName -HumanFriendly=Lambda Functions"; DO NOT EDIT.
+
+package lambda
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func DataSourceFunctions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceFunctionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": tftags.TagsSchema(),
+		},
+	}
+}
+
+func dataSourceFunctionsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LambdaConn
+
+	var nameRegex *regexp.Regexp
+
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+
+	tagsToMatch := tftags.New(d.Get("tags").(map[string]interface{})).IgnoreAWS()
+
+	input := &lambda.ListFunctionsInput{}
+
+	var ids, names []string
+	var tagsErr error
+
+	err := conn.ListFunctionsPages(input, func(page *lambda.ListFunctionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Functions {
+			if v == nil {
+				continue
+			}
+
+			id := aws.StringValue(v.FunctionArn)
+			name := aws.StringValue(v.FunctionName)
+
+			if nameRegex != nil && !nameRegex.MatchString(name) {
+				continue
+			}
+
+			if len(tagsToMatch) > 0 {
+				tags, err := ListTags(conn, id)
+
+				if err != nil {
+					tagsErr = fmt.Errorf("error listing tags for %s: %w", id, err)
+					return false
+				}
+
+				if !tags.ContainsAll(tagsToMatch) {
+					continue
+				}
+			}
+
+			ids = append(ids, id)
+			names = append(names, name)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading Lambda Functions: %w", err)
+	}
+
+	if tagsErr != nil {
+		return tagsErr
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+
+	if err := d.Set("arns", ids); err != nil {
+		return fmt.Errorf("error setting arns: %w", err)
+	}
+
+	if err := d.Set("names", names); err != nil {
+		return fmt.Errorf("error setting names: %w", err)
+	}
+
+	return nil
+}
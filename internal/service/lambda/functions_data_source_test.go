@@ -0,0 +1,65 @@
+package lambda_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lambda"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccLambdaFunctionsDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, lambda.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionsDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_lambda_functions.name_regex", "arns.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_lambda_functions.name_regex", "names.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_lambda_functions.tags", "arns.#", "1"),
+					resource.TestCheckResourceAttrPair("data.aws_lambda_functions.tags", "arns.0", "aws_lambda_function.test.0", "arn"),
+					resource.TestCheckResourceAttrPair("data.aws_lambda_functions.tags", "names.0", "aws_lambda_function.test.0", "function_name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFunctionsDataSourceConfig(rName string) string {
+	return testAccFunctionBaseDataSourceConfig(rName) + fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  count = 2
+
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = "%[1]s-${count.index}"
+  handler       = "exports.example"
+  role          = aws_iam_role.lambda.arn
+  runtime       = "nodejs12.x"
+
+  tags = {
+    Name = "%[1]s-${count.index}"
+  }
+}
+
+data "aws_lambda_functions" "name_regex" {
+  name_regex = "^%[1]s-"
+
+  depends_on = [aws_lambda_function.test]
+}
+
+data "aws_lambda_functions" "tags" {
+  tags = {
+    Name = "%[1]s-0"
+  }
+
+  depends_on = [aws_lambda_function.test]
+}
+`, rName)
+}
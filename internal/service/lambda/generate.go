@@ -1,4 +1,5 @@
 //go:generate go run ../../generate/tags/main.go -ListTags -ListTagsOp=ListTags -ListTagsInIDElem=Resource -ServiceTagsMap -TagInIDElem=Resource -UpdateTags
+//go:generate go run -tags generate ../../generate/listdatasource/main.go -Name=Functions -ListOp=ListFunctions -ListOutElem=Functions -IDElem=FunctionArn -NameElem=FunctionName "-HumanFriendly=Lambda Functions"
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package lambda
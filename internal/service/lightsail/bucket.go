@@ -0,0 +1,205 @@
+package lightsail
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceBucket() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBucketCreate,
+		Read:   resourceBucketRead,
+		Update: resourceBucketUpdate,
+		Delete: resourceBucketDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bundle_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"force_delete": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(3, 54),
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"support_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceBucketCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &lightsail.CreateBucketInput{
+		BucketName: aws.String(name),
+		BundleId:   aws.String(d.Get("bundle_id").(string)),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Lightsail Bucket: %s", input)
+	output, err := conn.CreateBucket(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Lightsail Bucket (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	if err := waitOperations(conn, output.Operations); err != nil {
+		return fmt.Errorf("error waiting for Lightsail Bucket (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceBucketRead(d, meta)
+}
+
+func resourceBucketRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	bucket, err := FindBucketByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lightsail Bucket (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Bucket (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", bucket.Arn)
+	d.Set("bundle_id", bucket.BundleId)
+	d.Set("created_at", aws.TimeValue(bucket.CreatedAt).Format(time.RFC3339))
+	d.Set("name", bucket.Name)
+	d.Set("support_code", bucket.SupportCode)
+	d.Set("url", bucket.Url)
+
+	if bucket.Location != nil {
+		d.Set("availability_zone", bucket.Location.AvailabilityZone)
+		d.Set("region", bucket.Location.RegionName)
+	}
+
+	tags := KeyValueTags(bucket.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceBucketUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+
+	if d.HasChange("bundle_id") {
+		input := &lightsail.UpdateBucketBundleInput{
+			BucketName: aws.String(d.Id()),
+			BundleId:   aws.String(d.Get("bundle_id").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating Lightsail Bucket bundle: %s", input)
+		output, err := conn.UpdateBucketBundle(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Lightsail Bucket (%s) bundle: %w", d.Id(), err)
+		}
+
+		if err := waitOperations(conn, output.Operations); err != nil {
+			return fmt.Errorf("error waiting for Lightsail Bucket (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating Lightsail Bucket (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceBucketRead(d, meta)
+}
+
+func resourceBucketDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+
+	log.Printf("[DEBUG] Deleting Lightsail Bucket: %s", d.Id())
+	output, err := conn.DeleteBucket(&lightsail.DeleteBucketInput{
+		BucketName:  aws.String(d.Id()),
+		ForceDelete: aws.Bool(d.Get("force_delete").(bool)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, lightsail.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Lightsail Bucket (%s): %w", d.Id(), err)
+	}
+
+	if err := waitOperations(conn, output.Operations); err != nil {
+		return fmt.Errorf("error waiting for Lightsail Bucket (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,160 @@
+package lightsail
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceBucketAccessKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBucketAccessKeyCreate,
+		Read:   resourceBucketAccessKeyRead,
+		Delete: resourceBucketAccessKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access_key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bucket_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"secret_access_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceBucketAccessKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+
+	bucketName := d.Get("bucket_name").(string)
+	input := &lightsail.CreateBucketAccessKeyInput{
+		BucketName: aws.String(bucketName),
+	}
+
+	log.Printf("[DEBUG] Creating Lightsail Bucket Access Key: %s", input)
+	output, err := conn.CreateBucketAccessKey(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Lightsail Bucket (%s) Access Key: %w", bucketName, err)
+	}
+
+	if output.AccessKey == nil {
+		return fmt.Errorf("error creating Lightsail Bucket (%s) Access Key: empty result", bucketName)
+	}
+
+	d.SetId(BucketAccessKeyCreateResourceID(bucketName, aws.StringValue(output.AccessKey.AccessKeyId)))
+
+	if err := waitOperations(conn, output.Operations); err != nil {
+		return fmt.Errorf("error waiting for Lightsail Bucket Access Key (%s) create: %w", d.Id(), err)
+	}
+
+	// The secret access key is only available in the response to the create request.
+	d.Set("secret_access_key", output.AccessKey.SecretAccessKey)
+
+	return resourceBucketAccessKeyRead(d, meta)
+}
+
+func resourceBucketAccessKeyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+
+	bucketName, accessKeyID, err := BucketAccessKeyParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	accessKey, err := FindBucketAccessKeyByID(conn, bucketName, accessKeyID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lightsail Bucket Access Key (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Bucket Access Key (%s): %w", d.Id(), err)
+	}
+
+	d.Set("access_key_id", accessKey.AccessKeyId)
+	d.Set("bucket_name", bucketName)
+	d.Set("created_at", aws.TimeValue(accessKey.CreatedAt).Format(time.RFC3339))
+	d.Set("status", accessKey.Status)
+
+	return nil
+}
+
+func resourceBucketAccessKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+
+	bucketName, accessKeyID, err := BucketAccessKeyParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Lightsail Bucket Access Key: %s", d.Id())
+	output, err := conn.DeleteBucketAccessKey(&lightsail.DeleteBucketAccessKeyInput{
+		AccessKeyId: aws.String(accessKeyID),
+		BucketName:  aws.String(bucketName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, lightsail.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Lightsail Bucket Access Key (%s): %w", d.Id(), err)
+	}
+
+	if err := waitOperations(conn, output.Operations); err != nil {
+		return fmt.Errorf("error waiting for Lightsail Bucket Access Key (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+const bucketAccessKeyResourceIDSeparator = ","
+
+func BucketAccessKeyCreateResourceID(bucketName, accessKeyID string) string {
+	parts := []string{bucketName, accessKeyID}
+	id := strings.Join(parts, bucketAccessKeyResourceIDSeparator)
+
+	return id
+}
+
+func BucketAccessKeyParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, bucketAccessKeyResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected BUCKET_NAME%[2]sACCESS_KEY_ID", id, bucketAccessKeyResourceIDSeparator)
+}
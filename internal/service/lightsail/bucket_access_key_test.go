@@ -0,0 +1,142 @@
+package lightsail_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflightsail "github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccLightsailBucketAccessKey_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-test-lightsail-%d", sdkacctest.RandInt())
+	resourceName := "aws_lightsail_bucket_access_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(lightsail.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, lightsail.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBucketAccessKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketAccessKeyConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketAccessKeyExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "access_key_id", regexp.MustCompile(`^[A-Z0-9]{20}$`)),
+					resource.TestCheckResourceAttr(resourceName, "bucket_name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttrSet(resourceName, "secret_access_key"),
+					resource.TestCheckResourceAttr(resourceName, "status", lightsail.StatusTypeActive),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret_access_key"},
+			},
+		},
+	})
+}
+
+func TestAccLightsailBucketAccessKey_disappears(t *testing.T) {
+	rName := fmt.Sprintf("tf-test-lightsail-%d", sdkacctest.RandInt())
+	resourceName := "aws_lightsail_bucket_access_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(lightsail.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, lightsail.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBucketAccessKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketAccessKeyConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketAccessKeyExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tflightsail.ResourceBucketAccessKey(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckBucketAccessKeyExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lightsail Bucket Access Key ID is set")
+		}
+
+		bucketName, accessKeyID, err := tflightsail.BucketAccessKeyParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LightsailConn
+
+		_, err = tflightsail.FindBucketAccessKeyByID(conn, bucketName, accessKeyID)
+
+		return err
+	}
+}
+
+func testAccCheckBucketAccessKeyDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LightsailConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lightsail_bucket_access_key" {
+			continue
+		}
+
+		bucketName, accessKeyID, err := tflightsail.BucketAccessKeyParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tflightsail.FindBucketAccessKeyByID(conn, bucketName, accessKeyID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Lightsail Bucket Access Key %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccBucketAccessKeyConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccBucketConfig_basic(rName, "small_1_0"),
+		`
+resource "aws_lightsail_bucket_access_key" "test" {
+  bucket_name = aws_lightsail_bucket.test.name
+}
+`)
+}
@@ -0,0 +1,225 @@
+package lightsail
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceCertificate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCertificateCreate,
+		Read:   resourceCertificateRead,
+		Update: resourceCertificateUpdate,
+		Delete: resourceCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"domain_validation_options": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"domain_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_record_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_record_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_record_value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subject_alternative_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceCertificateCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &lightsail.CreateCertificateInput{
+		CertificateName: aws.String(name),
+		DomainName:      aws.String(d.Get("domain_name").(string)),
+	}
+
+	if v, ok := d.GetOk("subject_alternative_names"); ok && v.(*schema.Set).Len() > 0 {
+		input.SubjectAlternativeNames = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Lightsail Certificate: %s", input)
+	output, err := conn.CreateCertificate(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Lightsail Certificate (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	if err := waitOperations(conn, output.Operations); err != nil {
+		return fmt.Errorf("error waiting for Lightsail Certificate (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceCertificateRead(d, meta)
+}
+
+func resourceCertificateRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	certificate, err := FindCertificateByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lightsail Certificate (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Certificate (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", certificate.Arn)
+	d.Set("created_at", aws.TimeValue(certificate.CreatedAt).Format(time.RFC3339))
+	d.Set("domain_name", certificate.DomainName)
+	d.Set("name", certificate.Name)
+	d.Set("status", certificate.Status)
+
+	if err := d.Set("domain_validation_options", flattenCertificateDomainValidationRecords(certificate.DomainValidationRecords)); err != nil {
+		return fmt.Errorf("error setting domain_validation_options: %w", err)
+	}
+
+	if err := d.Set("subject_alternative_names", aws.StringValueSlice(certificate.SubjectAlternativeNames)); err != nil {
+		return fmt.Errorf("error setting subject_alternative_names: %w", err)
+	}
+
+	tags := KeyValueTags(certificate.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceCertificateUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating Lightsail Certificate (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceCertificateRead(d, meta)
+}
+
+func resourceCertificateDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+
+	log.Printf("[DEBUG] Deleting Lightsail Certificate: %s", d.Id())
+	output, err := conn.DeleteCertificate(&lightsail.DeleteCertificateInput{
+		CertificateName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, lightsail.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Lightsail Certificate (%s): %w", d.Id(), err)
+	}
+
+	if err := waitOperations(conn, output.Operations); err != nil {
+		return fmt.Errorf("error waiting for Lightsail Certificate (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func flattenCertificateDomainValidationRecords(apiObjects []*lightsail.DomainValidationRecord) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil || apiObject.ResourceRecord == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"domain_name":           aws.StringValue(apiObject.DomainName),
+			"resource_record_name":  aws.StringValue(apiObject.ResourceRecord.Name),
+			"resource_record_type":  aws.StringValue(apiObject.ResourceRecord.Type),
+			"resource_record_value": aws.StringValue(apiObject.ResourceRecord.Value),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,246 @@
+package lightsail_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflightsail "github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccLightsailCertificate_basic(t *testing.T) {
+	var certificate lightsail.Certificate
+	rName := fmt.Sprintf("tf-test-lightsail-%d", sdkacctest.RandInt())
+	domainName := acctest.RandomDomainName()
+	resourceName := "aws_lightsail_certificate.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(lightsail.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, lightsail.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCertificateConfig_basic(rName, domainName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCertificateExists(resourceName, &certificate),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttr(resourceName, "domain_name", domainName),
+					resource.TestCheckResourceAttr(resourceName, "domain_validation_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", lightsail.CertificateStatusPendingValidation),
+					resource.TestCheckResourceAttr(resourceName, "subject_alternative_names.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "subject_alternative_names.*", domainName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccLightsailCertificate_subjectAlternativeNames(t *testing.T) {
+	var certificate lightsail.Certificate
+	rName := fmt.Sprintf("tf-test-lightsail-%d", sdkacctest.RandInt())
+	domainName := acctest.RandomDomainName()
+	subjectAlternativeName := acctest.RandomDomainName()
+	resourceName := "aws_lightsail_certificate.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(lightsail.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, lightsail.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCertificateConfig_subjectAlternativeNames(rName, domainName, subjectAlternativeName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCertificateExists(resourceName, &certificate),
+					resource.TestCheckResourceAttr(resourceName, "domain_validation_options.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "subject_alternative_names.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "subject_alternative_names.*", domainName),
+					resource.TestCheckTypeSetElemAttr(resourceName, "subject_alternative_names.*", subjectAlternativeName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLightsailCertificate_tags(t *testing.T) {
+	var certificate lightsail.Certificate
+	rName := fmt.Sprintf("tf-test-lightsail-%d", sdkacctest.RandInt())
+	domainName := acctest.RandomDomainName()
+	resourceName := "aws_lightsail_certificate.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(lightsail.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, lightsail.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCertificateConfig_tags1(rName, domainName, "key1", "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCertificateExists(resourceName, &certificate),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccCertificateConfig_tags2(rName, domainName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCertificateExists(resourceName, &certificate),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLightsailCertificate_disappears(t *testing.T) {
+	var certificate lightsail.Certificate
+	rName := fmt.Sprintf("tf-test-lightsail-%d", sdkacctest.RandInt())
+	domainName := acctest.RandomDomainName()
+	resourceName := "aws_lightsail_certificate.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(lightsail.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, lightsail.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCertificateConfig_basic(rName, domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCertificateExists(resourceName, &certificate),
+					acctest.CheckResourceDisappears(acctest.Provider, tflightsail.ResourceCertificate(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCertificateExists(n string, certificate *lightsail.Certificate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lightsail Certificate ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LightsailConn
+
+		output, err := tflightsail.FindCertificateByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*certificate = *output
+
+		return nil
+	}
+}
+
+func testAccCheckCertificateDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LightsailConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lightsail_certificate" {
+			continue
+		}
+
+		_, err := tflightsail.FindCertificateByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Lightsail Certificate %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCertificateConfig_basic(rName, domainName string) string {
+	return fmt.Sprintf(`
+resource "aws_lightsail_certificate" "test" {
+  name        = %[1]q
+  domain_name = %[2]q
+}
+`, rName, domainName)
+}
+
+func testAccCertificateConfig_subjectAlternativeNames(rName, domainName, subjectAlternativeName string) string {
+	return fmt.Sprintf(`
+resource "aws_lightsail_certificate" "test" {
+  name                      = %[1]q
+  domain_name               = %[2]q
+  subject_alternative_names = [%[3]q]
+}
+`, rName, domainName, subjectAlternativeName)
+}
+
+func testAccCertificateConfig_tags1(rName, domainName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_lightsail_certificate" "test" {
+  name        = %[1]q
+  domain_name = %[2]q
+
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rName, domainName, tagKey1, tagValue1)
+}
+
+func testAccCertificateConfig_tags2(rName, domainName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_lightsail_certificate" "test" {
+  name        = %[1]q
+  domain_name = %[2]q
+
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+}
+`, rName, domainName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,261 @@
+package lightsail_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflightsail "github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccLightsailContainerService_basic(t *testing.T) {
+	var cs lightsail.ContainerService
+	rName := fmt.Sprintf("tf-test-lightsail-%d", sdkacctest.RandInt())
+	resourceName := "aws_lightsail_container_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(lightsail.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, lightsail.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckContainerServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerServiceConfig_basic(rName, lightsail.ContainerServicePowerNameNano, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckContainerServiceExists(resourceName, &cs),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "availability_zone"),
+					resource.TestCheckResourceAttr(resourceName, "is_disabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "power", lightsail.ContainerServicePowerNameNano),
+					resource.TestCheckResourceAttrSet(resourceName, "principal_arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "private_domain_name"),
+					resource.TestCheckResourceAttr(resourceName, "public_domain_names.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "scale", "1"),
+					resource.TestCheckResourceAttr(resourceName, "state", lightsail.ContainerServiceStateReady),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "url"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccContainerServiceConfig_basic(rName, lightsail.ContainerServicePowerNameMicro, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckContainerServiceExists(resourceName, &cs),
+					resource.TestCheckResourceAttr(resourceName, "power", lightsail.ContainerServicePowerNameMicro),
+					resource.TestCheckResourceAttr(resourceName, "scale", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLightsailContainerService_isDisabled(t *testing.T) {
+	var cs lightsail.ContainerService
+	rName := fmt.Sprintf("tf-test-lightsail-%d", sdkacctest.RandInt())
+	resourceName := "aws_lightsail_container_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(lightsail.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, lightsail.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckContainerServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerServiceConfig_isDisabled(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckContainerServiceExists(resourceName, &cs),
+					resource.TestCheckResourceAttr(resourceName, "is_disabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "state", lightsail.ContainerServiceStateDisabled),
+				),
+			},
+			{
+				Config: testAccContainerServiceConfig_isDisabled(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckContainerServiceExists(resourceName, &cs),
+					resource.TestCheckResourceAttr(resourceName, "is_disabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLightsailContainerService_tags(t *testing.T) {
+	var cs lightsail.ContainerService
+	rName := fmt.Sprintf("tf-test-lightsail-%d", sdkacctest.RandInt())
+	resourceName := "aws_lightsail_container_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(lightsail.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, lightsail.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckContainerServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerServiceConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckContainerServiceExists(resourceName, &cs),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccContainerServiceConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckContainerServiceExists(resourceName, &cs),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLightsailContainerService_disappears(t *testing.T) {
+	var cs lightsail.ContainerService
+	rName := fmt.Sprintf("tf-test-lightsail-%d", sdkacctest.RandInt())
+	resourceName := "aws_lightsail_container_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(lightsail.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, lightsail.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckContainerServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerServiceConfig_basic(rName, lightsail.ContainerServicePowerNameNano, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerServiceExists(resourceName, &cs),
+					acctest.CheckResourceDisappears(acctest.Provider, tflightsail.ResourceContainerService(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckContainerServiceExists(n string, cs *lightsail.ContainerService) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lightsail Container Service ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LightsailConn
+
+		output, err := tflightsail.FindContainerServiceByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*cs = *output
+
+		return nil
+	}
+}
+
+func testAccCheckContainerServiceDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LightsailConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lightsail_container_service" {
+			continue
+		}
+
+		_, err := tflightsail.FindContainerServiceByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Lightsail Container Service %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccContainerServiceConfig_basic(rName, power string, scale int) string {
+	return fmt.Sprintf(`
+resource "aws_lightsail_container_service" "test" {
+  name  = %[1]q
+  power = %[2]q
+  scale = %[3]d
+}
+`, rName, power, scale)
+}
+
+func testAccContainerServiceConfig_isDisabled(rName string, isDisabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_lightsail_container_service" "test" {
+  name        = %[1]q
+  power       = "nano"
+  scale       = 1
+  is_disabled = %[2]t
+}
+`, rName, isDisabled)
+}
+
+func testAccContainerServiceConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_lightsail_container_service" "test" {
+  name  = %[1]q
+  power = "nano"
+  scale = 1
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccContainerServiceConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_lightsail_container_service" "test" {
+  name  = %[1]q
+  power = "nano"
+  scale = 1
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,416 @@
+package lightsail
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDatabase() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDatabaseCreate,
+		Read:   resourceDatabaseRead,
+		Update: resourceDatabaseUpdate,
+		Delete: resourceDatabaseDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DatabaseTimeout),
+			Update: schema.DefaultTimeout(DatabaseTimeout),
+			Delete: schema.DefaultTimeout(DatabaseTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"apply_immediately": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"backup_retention_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"blueprint_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"bundle_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ca_certificate_identifier": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cpu_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"disk_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"engine": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"engine_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"final_snapshot_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(2, 255),
+					validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9-]+[A-Za-z0-9]$`), "must begin and end with an alphanumeric character and contain only alphanumeric characters and hyphens"),
+				),
+			},
+			"master_database_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile(`^[A-Za-z]`), "must begin with a letter"),
+					validation.StringMatch(regexp.MustCompile(`^[0-9A-Za-z_]+$`), "must contain only alphanumeric characters and underscores"),
+				),
+			},
+			"master_endpoint_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"master_endpoint_port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"master_password": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(8, 128),
+			},
+			"master_username": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 63),
+					validation.StringMatch(regexp.MustCompile(`^[A-Za-z]`), "must begin with a letter"),
+					validation.StringMatch(regexp.MustCompile(`^[0-9A-Za-z_]+$`), "must contain only alphanumeric characters and underscores"),
+				),
+			},
+			"preferred_backup_window": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidOnceADayWindowFormat,
+			},
+			"preferred_maintenance_window": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidOnceAWeekWindowFormat,
+			},
+			"publicly_accessible": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"ram_size": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"relational_database_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(2, 255),
+					validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9-]+[A-Za-z0-9]$`), "must begin and end with an alphanumeric character and contain only alphanumeric characters and hyphens"),
+				),
+			},
+			"secondary_availability_zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"skip_final_snapshot": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"support_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDatabaseCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("relational_database_name").(string)
+	input := &lightsail.CreateRelationalDatabaseInput{
+		MasterDatabaseName:            aws.String(d.Get("master_database_name").(string)),
+		MasterUsername:                aws.String(d.Get("master_username").(string)),
+		MasterUserPassword:            aws.String(d.Get("master_password").(string)),
+		PubliclyAccessible:            aws.Bool(d.Get("publicly_accessible").(bool)),
+		RelationalDatabaseBlueprintId: aws.String(d.Get("blueprint_id").(string)),
+		RelationalDatabaseBundleId:    aws.String(d.Get("bundle_id").(string)),
+		RelationalDatabaseName:        aws.String(name),
+	}
+
+	if v, ok := d.GetOk("availability_zone"); ok {
+		input.AvailabilityZone = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("preferred_backup_window"); ok {
+		input.PreferredBackupWindow = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("preferred_maintenance_window"); ok {
+		input.PreferredMaintenanceWindow = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Lightsail Database: %s", input)
+	output, err := conn.CreateRelationalDatabase(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Lightsail Database (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	if err := waitOperations(conn, output.Operations); err != nil {
+		return fmt.Errorf("error waiting for Lightsail Database (%s) create: %w", d.Id(), err)
+	}
+
+	if _, err := waitDatabaseAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Lightsail Database (%s) to become available: %w", d.Id(), err)
+	}
+
+	// Backup retention is enabled by default and can only be disabled once the database exists.
+	if !d.Get("backup_retention_enabled").(bool) {
+		input := &lightsail.UpdateRelationalDatabaseInput{
+			ApplyImmediately:       aws.Bool(true),
+			DisableBackupRetention: aws.Bool(true),
+			RelationalDatabaseName: aws.String(d.Id()),
+		}
+
+		output, err := conn.UpdateRelationalDatabase(input)
+
+		if err != nil {
+			return fmt.Errorf("error disabling Lightsail Database (%s) backup retention: %w", d.Id(), err)
+		}
+
+		if err := waitOperations(conn, output.Operations); err != nil {
+			return fmt.Errorf("error waiting for Lightsail Database (%s) update: %w", d.Id(), err)
+		}
+
+		if _, err := waitDatabaseAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return fmt.Errorf("error waiting for Lightsail Database (%s) to become available: %w", d.Id(), err)
+		}
+	}
+
+	return resourceDatabaseRead(d, meta)
+}
+
+func resourceDatabaseRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	database, err := FindDatabaseByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lightsail Database (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Database (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", database.Arn)
+	d.Set("backup_retention_enabled", database.BackupRetentionEnabled)
+	d.Set("blueprint_id", database.RelationalDatabaseBlueprintId)
+	d.Set("bundle_id", database.RelationalDatabaseBundleId)
+	d.Set("ca_certificate_identifier", database.CaCertificateIdentifier)
+	d.Set("created_at", aws.TimeValue(database.CreatedAt).Format(time.RFC3339))
+	d.Set("engine", database.Engine)
+	d.Set("engine_version", database.EngineVersion)
+	d.Set("master_database_name", database.MasterDatabaseName)
+	d.Set("master_username", database.MasterUsername)
+	d.Set("preferred_backup_window", database.PreferredBackupWindow)
+	d.Set("preferred_maintenance_window", database.PreferredMaintenanceWindow)
+	d.Set("publicly_accessible", database.PubliclyAccessible)
+	d.Set("relational_database_name", database.Name)
+	d.Set("secondary_availability_zone", database.SecondaryAvailabilityZone)
+	d.Set("support_code", database.SupportCode)
+
+	if database.Location != nil {
+		d.Set("availability_zone", database.Location.AvailabilityZone)
+	}
+
+	if hardware := database.Hardware; hardware != nil {
+		d.Set("cpu_count", hardware.CpuCount)
+		d.Set("disk_size", hardware.DiskSizeInGb)
+		d.Set("ram_size", hardware.RamSizeInGb)
+	}
+
+	if endpoint := database.MasterEndpoint; endpoint != nil {
+		d.Set("master_endpoint_address", endpoint.Address)
+		d.Set("master_endpoint_port", endpoint.Port)
+	}
+
+	tags := KeyValueTags(database.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceDatabaseUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+
+	if d.HasChangesExcept("apply_immediately", "final_snapshot_name", "skip_final_snapshot", "tags", "tags_all") {
+		input := &lightsail.UpdateRelationalDatabaseInput{
+			ApplyImmediately:       aws.Bool(d.Get("apply_immediately").(bool)),
+			RelationalDatabaseName: aws.String(d.Id()),
+		}
+
+		if d.HasChange("backup_retention_enabled") {
+			if d.Get("backup_retention_enabled").(bool) {
+				input.EnableBackupRetention = aws.Bool(true)
+			} else {
+				input.DisableBackupRetention = aws.Bool(true)
+			}
+		}
+
+		if d.HasChange("master_password") {
+			input.MasterUserPassword = aws.String(d.Get("master_password").(string))
+		}
+
+		if d.HasChange("preferred_backup_window") {
+			input.PreferredBackupWindow = aws.String(d.Get("preferred_backup_window").(string))
+		}
+
+		if d.HasChange("preferred_maintenance_window") {
+			input.PreferredMaintenanceWindow = aws.String(d.Get("preferred_maintenance_window").(string))
+		}
+
+		if d.HasChange("publicly_accessible") {
+			input.PubliclyAccessible = aws.Bool(d.Get("publicly_accessible").(bool))
+		}
+
+		log.Printf("[DEBUG] Updating Lightsail Database: %s", input)
+		output, err := conn.UpdateRelationalDatabase(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Lightsail Database (%s): %w", d.Id(), err)
+		}
+
+		if err := waitOperations(conn, output.Operations); err != nil {
+			return fmt.Errorf("error waiting for Lightsail Database (%s) update: %w", d.Id(), err)
+		}
+
+		if _, err := waitDatabaseAvailable(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Lightsail Database (%s) to become available: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating Lightsail Database (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceDatabaseRead(d, meta)
+}
+
+func resourceDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+
+	input := &lightsail.DeleteRelationalDatabaseInput{
+		RelationalDatabaseName: aws.String(d.Id()),
+		SkipFinalSnapshot:      aws.Bool(d.Get("skip_final_snapshot").(bool)),
+	}
+
+	if !d.Get("skip_final_snapshot").(bool) {
+		v, ok := d.GetOk("final_snapshot_name")
+
+		if !ok {
+			return fmt.Errorf("error deleting Lightsail Database (%s): final_snapshot_name is required when skip_final_snapshot is false", d.Id())
+		}
+
+		input.FinalRelationalDatabaseSnapshotName = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Deleting Lightsail Database: %s", d.Id())
+	output, err := conn.DeleteRelationalDatabase(input)
+
+	if tfawserr.ErrCodeEquals(err, lightsail.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Lightsail Database (%s): %w", d.Id(), err)
+	}
+
+	if err := waitOperations(conn, output.Operations); err != nil {
+		return fmt.Errorf("error waiting for Lightsail Database (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
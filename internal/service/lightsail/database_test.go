@@ -0,0 +1,304 @@
+package lightsail_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflightsail "github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccLightsailDatabase_basic(t *testing.T) {
+	var database lightsail.RelationalDatabase
+	rName := fmt.Sprintf("tf-test-lightsail-%d", sdkacctest.RandInt())
+	resourceName := "aws_lightsail_database.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(lightsail.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, lightsail.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDatabaseExists(resourceName, &database),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "availability_zone"),
+					resource.TestCheckResourceAttr(resourceName, "backup_retention_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "blueprint_id", "mysql_8_0"),
+					resource.TestCheckResourceAttr(resourceName, "bundle_id", "micro_1_0"),
+					resource.TestCheckResourceAttr(resourceName, "engine", "mysql"),
+					resource.TestCheckResourceAttr(resourceName, "master_database_name", "testdatabasename"),
+					resource.TestCheckResourceAttrSet(resourceName, "master_endpoint_address"),
+					resource.TestCheckResourceAttr(resourceName, "master_endpoint_port", "3306"),
+					resource.TestCheckResourceAttr(resourceName, "master_username", "test"),
+					resource.TestCheckResourceAttr(resourceName, "publicly_accessible", "false"),
+					resource.TestCheckResourceAttr(resourceName, "relational_database_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"apply_immediately",
+					"final_snapshot_name",
+					"master_password",
+					"skip_final_snapshot",
+				},
+			},
+		},
+	})
+}
+
+func TestAccLightsailDatabase_update(t *testing.T) {
+	var database lightsail.RelationalDatabase
+	rName := fmt.Sprintf("tf-test-lightsail-%d", sdkacctest.RandInt())
+	resourceName := "aws_lightsail_database.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(lightsail.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, lightsail.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseConfig_update(rName, false, "09:30-10:00"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDatabaseExists(resourceName, &database),
+					resource.TestCheckResourceAttr(resourceName, "backup_retention_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "preferred_backup_window", "09:30-10:00"),
+				),
+			},
+			{
+				Config: testAccDatabaseConfig_update(rName, true, "10:30-11:00"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDatabaseExists(resourceName, &database),
+					resource.TestCheckResourceAttr(resourceName, "backup_retention_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "preferred_backup_window", "10:30-11:00"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLightsailDatabase_tags(t *testing.T) {
+	var database lightsail.RelationalDatabase
+	rName := fmt.Sprintf("tf-test-lightsail-%d", sdkacctest.RandInt())
+	resourceName := "aws_lightsail_database.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(lightsail.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, lightsail.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDatabaseExists(resourceName, &database),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccDatabaseConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDatabaseExists(resourceName, &database),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLightsailDatabase_disappears(t *testing.T) {
+	var database lightsail.RelationalDatabase
+	rName := fmt.Sprintf("tf-test-lightsail-%d", sdkacctest.RandInt())
+	resourceName := "aws_lightsail_database.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(lightsail.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, lightsail.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatabaseExists(resourceName, &database),
+					acctest.CheckResourceDisappears(acctest.Provider, tflightsail.ResourceDatabase(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDatabaseExists(n string, database *lightsail.RelationalDatabase) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lightsail Database ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LightsailConn
+
+		output, err := tflightsail.FindDatabaseByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*database = *output
+
+		return nil
+	}
+}
+
+func testAccCheckDatabaseDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LightsailConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lightsail_database" {
+			continue
+		}
+
+		_, err := tflightsail.FindDatabaseByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Lightsail Database %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccDatabaseConfigBase() string {
+	return `
+data "aws_availability_zones" "available" {
+  state = "available"
+
+  filter {
+    name   = "opt-in-status"
+    values = ["opt-in-not-required"]
+  }
+}
+`
+}
+
+func testAccDatabaseConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccDatabaseConfigBase(),
+		fmt.Sprintf(`
+resource "aws_lightsail_database" "test" {
+  relational_database_name = %[1]q
+  availability_zone        = data.aws_availability_zones.available.names[0]
+  master_database_name     = "testdatabasename"
+  master_password          = "testdatabasepassword"
+  master_username          = "test"
+  blueprint_id             = "mysql_8_0"
+  bundle_id                = "micro_1_0"
+  skip_final_snapshot      = true
+}
+`, rName))
+}
+
+func testAccDatabaseConfig_update(rName string, backupRetentionEnabled bool, backupWindow string) string {
+	return acctest.ConfigCompose(
+		testAccDatabaseConfigBase(),
+		fmt.Sprintf(`
+resource "aws_lightsail_database" "test" {
+  relational_database_name = %[1]q
+  availability_zone        = data.aws_availability_zones.available.names[0]
+  master_database_name     = "testdatabasename"
+  master_password          = "testdatabasepassword"
+  master_username          = "test"
+  blueprint_id             = "mysql_8_0"
+  bundle_id                = "micro_1_0"
+  backup_retention_enabled = %[2]t
+  preferred_backup_window  = %[3]q
+  apply_immediately        = true
+  skip_final_snapshot      = true
+}
+`, rName, backupRetentionEnabled, backupWindow))
+}
+
+func testAccDatabaseConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(
+		testAccDatabaseConfigBase(),
+		fmt.Sprintf(`
+resource "aws_lightsail_database" "test" {
+  relational_database_name = %[1]q
+  availability_zone        = data.aws_availability_zones.available.names[0]
+  master_database_name     = "testdatabasename"
+  master_password          = "testdatabasepassword"
+  master_username          = "test"
+  blueprint_id             = "mysql_8_0"
+  bundle_id                = "micro_1_0"
+  skip_final_snapshot      = true
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccDatabaseConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(
+		testAccDatabaseConfigBase(),
+		fmt.Sprintf(`
+resource "aws_lightsail_database" "test" {
+  relational_database_name = %[1]q
+  availability_zone        = data.aws_availability_zones.available.names[0]
+  master_database_name     = "testdatabasename"
+  master_password          = "testdatabasepassword"
+  master_username          = "test"
+  blueprint_id             = "mysql_8_0"
+  bundle_id                = "micro_1_0"
+  skip_final_snapshot      = true
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,266 @@
+package lightsail
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindDatabaseByName(conn *lightsail.Lightsail, name string) (*lightsail.RelationalDatabase, error) {
+	input := &lightsail.GetRelationalDatabaseInput{
+		RelationalDatabaseName: aws.String(name),
+	}
+
+	output, err := conn.GetRelationalDatabase(input)
+
+	if tfawserr.ErrCodeEquals(err, lightsail.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.RelationalDatabase == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.RelationalDatabase, nil
+}
+
+func FindContainerServiceByName(conn *lightsail.Lightsail, name string) (*lightsail.ContainerService, error) {
+	input := &lightsail.GetContainerServicesInput{
+		ServiceName: aws.String(name),
+	}
+
+	output, err := conn.GetContainerServices(input)
+
+	if tfawserr.ErrCodeEquals(err, lightsail.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.ContainerServices) == 0 || output.ContainerServices[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ContainerServices[0], nil
+}
+
+func FindContainerServiceDeploymentByVersion(conn *lightsail.Lightsail, serviceName string, version int) (*lightsail.ContainerServiceDeployment, error) {
+	input := &lightsail.GetContainerServiceDeploymentsInput{
+		ServiceName: aws.String(serviceName),
+	}
+
+	output, err := conn.GetContainerServiceDeployments(input)
+
+	if tfawserr.ErrCodeEquals(err, lightsail.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, deployment := range output.Deployments {
+		if deployment == nil {
+			continue
+		}
+
+		if int(aws.Int64Value(deployment.Version)) == version {
+			return deployment, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{
+		LastRequest: input,
+	}
+}
+
+func FindBucketByName(conn *lightsail.Lightsail, name string) (*lightsail.Bucket, error) {
+	input := &lightsail.GetBucketsInput{
+		BucketName: aws.String(name),
+	}
+
+	output, err := conn.GetBuckets(input)
+
+	if tfawserr.ErrCodeEquals(err, lightsail.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Buckets) == 0 || output.Buckets[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Buckets[0], nil
+}
+
+func FindBucketAccessKeyByID(conn *lightsail.Lightsail, bucketName, accessKeyID string) (*lightsail.AccessKey, error) {
+	input := &lightsail.GetBucketAccessKeysInput{
+		BucketName: aws.String(bucketName),
+	}
+
+	output, err := conn.GetBucketAccessKeys(input)
+
+	if tfawserr.ErrCodeEquals(err, lightsail.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, accessKey := range output.AccessKeys {
+		if accessKey == nil {
+			continue
+		}
+
+		if aws.StringValue(accessKey.AccessKeyId) == accessKeyID {
+			return accessKey, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{
+		LastRequest: input,
+	}
+}
+
+func FindLoadBalancerByName(conn *lightsail.Lightsail, name string) (*lightsail.LoadBalancer, error) {
+	input := &lightsail.GetLoadBalancerInput{
+		LoadBalancerName: aws.String(name),
+	}
+
+	output, err := conn.GetLoadBalancer(input)
+
+	if tfawserr.ErrCodeEquals(err, lightsail.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.LoadBalancer == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.LoadBalancer, nil
+}
+
+func FindLoadBalancerAttachment(conn *lightsail.Lightsail, lbName, instanceName string) (*lightsail.InstanceHealthSummary, error) {
+	lb, err := FindLoadBalancerByName(conn, lbName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, summary := range lb.InstanceHealthSummary {
+		if summary == nil {
+			continue
+		}
+
+		if aws.StringValue(summary.InstanceName) == instanceName {
+			return summary, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{}
+}
+
+func FindLoadBalancerCertificate(conn *lightsail.Lightsail, lbName, certificateName string) (*lightsail.LoadBalancerTlsCertificate, error) {
+	input := &lightsail.GetLoadBalancerTlsCertificatesInput{
+		LoadBalancerName: aws.String(lbName),
+	}
+
+	output, err := conn.GetLoadBalancerTlsCertificates(input)
+
+	if tfawserr.ErrCodeEquals(err, lightsail.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, certificate := range output.TlsCertificates {
+		if certificate == nil {
+			continue
+		}
+
+		if aws.StringValue(certificate.Name) == certificateName {
+			return certificate, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{
+		LastRequest: input,
+	}
+}
+
+func FindCertificateByName(conn *lightsail.Lightsail, name string) (*lightsail.Certificate, error) {
+	input := &lightsail.GetCertificatesInput{
+		CertificateName:           aws.String(name),
+		IncludeCertificateDetails: aws.Bool(true),
+	}
+
+	output, err := conn.GetCertificates(input)
+
+	if tfawserr.ErrCodeEquals(err, lightsail.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Certificates) == 0 || output.Certificates[0] == nil || output.Certificates[0].CertificateDetail == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Certificates[0].CertificateDetail, nil
+}
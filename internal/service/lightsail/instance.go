@@ -167,7 +167,7 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"Started"},
 		Target:     []string{"Completed", "Succeeded"},
-		Refresh:    resourceOperationRefreshFunc(op.Id, meta),
+		Refresh:    statusOperation(conn, op.Id),
 		Timeout:    10 * time.Minute,
 		Delay:      5 * time.Second,
 		MinTimeout: 3 * time.Second,
@@ -263,7 +263,7 @@ func resourceInstanceDelete(d *schema.ResourceData, meta interface{}) error {
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"Started"},
 		Target:     []string{"Completed", "Succeeded"},
-		Refresh:    resourceOperationRefreshFunc(op.Id, meta),
+		Refresh:    statusOperation(conn, op.Id),
 		Timeout:    10 * time.Minute,
 		Delay:      5 * time.Second,
 		MinTimeout: 3 * time.Second,
@@ -292,32 +292,3 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	return resourceInstanceRead(d, meta)
 }
-
-// method to check the status of an Operation, which is returned from
-// Create/Delete methods.
-// Status's are an aws.OperationStatus enum:
-// - NotStarted
-// - Started
-// - Failed
-// - Completed
-// - Succeeded (not documented?)
-func resourceOperationRefreshFunc(
-	oid *string, meta interface{}) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		conn := meta.(*conns.AWSClient).LightsailConn
-		log.Printf("[DEBUG] Checking if Lightsail Operation (%s) is Completed", *oid)
-		o, err := conn.GetOperation(&lightsail.GetOperationInput{
-			OperationId: oid,
-		})
-		if err != nil {
-			return o, "FAILED", err
-		}
-
-		if o.Operation == nil {
-			return nil, "Failed", fmt.Errorf("Error retrieving Operation info for operation (%s)", *oid)
-		}
-
-		log.Printf("[DEBUG] Lightsail Operation (%s) is currently %q", *oid, *o.Operation.Status)
-		return o, *o.Operation.Status, nil
-	}
-}
@@ -0,0 +1,220 @@
+package lightsail
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceLoadBalancer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLoadBalancerCreate,
+		Read:   resourceLoadBalancerRead,
+		Update: resourceLoadBalancerUpdate,
+		Delete: resourceLoadBalancerDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dns_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"health_check_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "/",
+			},
+			"instance_port": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(0, 65535),
+			},
+			"ip_address_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      lightsail.IpAddressTypeDualstack,
+				ValidateFunc: validation.StringInSlice(lightsail.IpAddressType_Values(), false),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(2, 255),
+					validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9-_.]+[A-Za-z0-9]$`), "must begin and end with an alphanumeric character and contain only alphanumeric characters, hyphens, underscores and periods"),
+				),
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"public_ports": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"support_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceLoadBalancerCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &lightsail.CreateLoadBalancerInput{
+		HealthCheckPath:  aws.String(d.Get("health_check_path").(string)),
+		InstancePort:     aws.Int64(int64(d.Get("instance_port").(int))),
+		IpAddressType:    aws.String(d.Get("ip_address_type").(string)),
+		LoadBalancerName: aws.String(name),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Lightsail Load Balancer: %s", input)
+	output, err := conn.CreateLoadBalancer(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Lightsail Load Balancer (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	if err := waitOperations(conn, output.Operations); err != nil {
+		return fmt.Errorf("error waiting for Lightsail Load Balancer (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceLoadBalancerRead(d, meta)
+}
+
+func resourceLoadBalancerRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	lb, err := FindLoadBalancerByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lightsail Load Balancer (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Load Balancer (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", lb.Arn)
+	d.Set("created_at", aws.TimeValue(lb.CreatedAt).Format(time.RFC3339))
+	d.Set("dns_name", lb.DnsName)
+	d.Set("health_check_path", lb.HealthCheckPath)
+	d.Set("instance_port", lb.InstancePort)
+	d.Set("ip_address_type", lb.IpAddressType)
+	d.Set("name", lb.Name)
+	d.Set("protocol", lb.Protocol)
+	d.Set("public_ports", aws.Int64ValueSlice(lb.PublicPorts))
+	d.Set("support_code", lb.SupportCode)
+
+	tags := KeyValueTags(lb.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceLoadBalancerUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+
+	if d.HasChange("health_check_path") {
+		input := &lightsail.UpdateLoadBalancerAttributeInput{
+			AttributeName:    aws.String(lightsail.LoadBalancerAttributeNameHealthCheckPath),
+			AttributeValue:   aws.String(d.Get("health_check_path").(string)),
+			LoadBalancerName: aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating Lightsail Load Balancer attribute: %s", input)
+		output, err := conn.UpdateLoadBalancerAttribute(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Lightsail Load Balancer (%s) health check path: %w", d.Id(), err)
+		}
+
+		if err := waitOperations(conn, output.Operations); err != nil {
+			return fmt.Errorf("error waiting for Lightsail Load Balancer (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating Lightsail Load Balancer (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceLoadBalancerRead(d, meta)
+}
+
+func resourceLoadBalancerDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+
+	log.Printf("[DEBUG] Deleting Lightsail Load Balancer: %s", d.Id())
+	output, err := conn.DeleteLoadBalancer(&lightsail.DeleteLoadBalancerInput{
+		LoadBalancerName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, lightsail.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Lightsail Load Balancer (%s): %w", d.Id(), err)
+	}
+
+	if err := waitOperations(conn, output.Operations); err != nil {
+		return fmt.Errorf("error waiting for Lightsail Load Balancer (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
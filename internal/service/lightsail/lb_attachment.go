@@ -0,0 +1,141 @@
+package lightsail
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceLoadBalancerAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLoadBalancerAttachmentCreate,
+		Read:   resourceLoadBalancerAttachmentRead,
+		Delete: resourceLoadBalancerAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"lb_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceLoadBalancerAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+
+	lbName := d.Get("lb_name").(string)
+	instanceName := d.Get("instance_name").(string)
+	id := LoadBalancerAttachmentCreateResourceID(lbName, instanceName)
+	input := &lightsail.AttachInstancesToLoadBalancerInput{
+		InstanceNames:    aws.StringSlice([]string{instanceName}),
+		LoadBalancerName: aws.String(lbName),
+	}
+
+	log.Printf("[DEBUG] Creating Lightsail Load Balancer Attachment: %s", input)
+	output, err := conn.AttachInstancesToLoadBalancer(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Lightsail Load Balancer Attachment (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	if err := waitOperations(conn, output.Operations); err != nil {
+		return fmt.Errorf("error waiting for Lightsail Load Balancer Attachment (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceLoadBalancerAttachmentRead(d, meta)
+}
+
+func resourceLoadBalancerAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+
+	lbName, instanceName, err := LoadBalancerAttachmentParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	_, err = FindLoadBalancerAttachment(conn, lbName, instanceName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lightsail Load Balancer Attachment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Load Balancer Attachment (%s): %w", d.Id(), err)
+	}
+
+	d.Set("instance_name", instanceName)
+	d.Set("lb_name", lbName)
+
+	return nil
+}
+
+func resourceLoadBalancerAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+
+	lbName, instanceName, err := LoadBalancerAttachmentParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Lightsail Load Balancer Attachment: %s", d.Id())
+	output, err := conn.DetachInstancesFromLoadBalancer(&lightsail.DetachInstancesFromLoadBalancerInput{
+		InstanceNames:    aws.StringSlice([]string{instanceName}),
+		LoadBalancerName: aws.String(lbName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, lightsail.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Lightsail Load Balancer Attachment (%s): %w", d.Id(), err)
+	}
+
+	if err := waitOperations(conn, output.Operations); err != nil {
+		return fmt.Errorf("error waiting for Lightsail Load Balancer Attachment (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+const loadBalancerAttachmentResourceIDSeparator = ","
+
+func LoadBalancerAttachmentCreateResourceID(lbName, instanceName string) string {
+	parts := []string{lbName, instanceName}
+	id := strings.Join(parts, loadBalancerAttachmentResourceIDSeparator)
+
+	return id
+}
+
+func LoadBalancerAttachmentParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, loadBalancerAttachmentResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected LB_NAME%[2]sINSTANCE_NAME", id, loadBalancerAttachmentResourceIDSeparator)
+}
@@ -0,0 +1,228 @@
+package lightsail
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceLoadBalancerCertificate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLoadBalancerCertificateCreate,
+		Read:   resourceLoadBalancerCertificateRead,
+		Delete: resourceLoadBalancerCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"domain_validation_records": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"domain_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_record_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_record_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_record_value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"lb_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"subject_alternative_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"support_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceLoadBalancerCertificateCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+
+	lbName := d.Get("lb_name").(string)
+	name := d.Get("name").(string)
+	id := LoadBalancerCertificateCreateResourceID(lbName, name)
+	input := &lightsail.CreateLoadBalancerTlsCertificateInput{
+		CertificateDomainName: aws.String(d.Get("domain_name").(string)),
+		CertificateName:       aws.String(name),
+		LoadBalancerName:      aws.String(lbName),
+	}
+
+	if v, ok := d.GetOk("subject_alternative_names"); ok && v.(*schema.Set).Len() > 0 {
+		input.CertificateAlternativeNames = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	log.Printf("[DEBUG] Creating Lightsail Load Balancer Certificate: %s", input)
+	output, err := conn.CreateLoadBalancerTlsCertificate(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Lightsail Load Balancer Certificate (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	if err := waitOperations(conn, output.Operations); err != nil {
+		return fmt.Errorf("error waiting for Lightsail Load Balancer Certificate (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceLoadBalancerCertificateRead(d, meta)
+}
+
+func resourceLoadBalancerCertificateRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+
+	lbName, name, err := LoadBalancerCertificateParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	certificate, err := FindLoadBalancerCertificate(conn, lbName, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lightsail Load Balancer Certificate (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Load Balancer Certificate (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", certificate.Arn)
+	d.Set("created_at", aws.TimeValue(certificate.CreatedAt).Format(time.RFC3339))
+	d.Set("domain_name", certificate.DomainName)
+	d.Set("lb_name", certificate.LoadBalancerName)
+	d.Set("name", certificate.Name)
+	d.Set("support_code", certificate.SupportCode)
+
+	if err := d.Set("domain_validation_records", flattenLoadBalancerCertificateDomainValidationRecords(certificate.DomainValidationRecords)); err != nil {
+		return fmt.Errorf("error setting domain_validation_records: %w", err)
+	}
+
+	if err := d.Set("subject_alternative_names", aws.StringValueSlice(certificate.SubjectAlternativeNames)); err != nil {
+		return fmt.Errorf("error setting subject_alternative_names: %w", err)
+	}
+
+	return nil
+}
+
+func resourceLoadBalancerCertificateDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+
+	lbName, name, err := LoadBalancerCertificateParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Lightsail Load Balancer Certificate: %s", d.Id())
+	output, err := conn.DeleteLoadBalancerTlsCertificate(&lightsail.DeleteLoadBalancerTlsCertificateInput{
+		CertificateName:  aws.String(name),
+		Force:            aws.Bool(true),
+		LoadBalancerName: aws.String(lbName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, lightsail.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Lightsail Load Balancer Certificate (%s): %w", d.Id(), err)
+	}
+
+	if err := waitOperations(conn, output.Operations); err != nil {
+		return fmt.Errorf("error waiting for Lightsail Load Balancer Certificate (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+const loadBalancerCertificateResourceIDSeparator = ","
+
+func LoadBalancerCertificateCreateResourceID(lbName, certificateName string) string {
+	parts := []string{lbName, certificateName}
+	id := strings.Join(parts, loadBalancerCertificateResourceIDSeparator)
+
+	return id
+}
+
+func LoadBalancerCertificateParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, loadBalancerCertificateResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected LB_NAME%[2]sCERTIFICATE_NAME", id, loadBalancerCertificateResourceIDSeparator)
+}
+
+func flattenLoadBalancerCertificateDomainValidationRecords(apiObjects []*lightsail.LoadBalancerTlsCertificateDomainValidationRecord) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"domain_name":           aws.StringValue(apiObject.DomainName),
+			"resource_record_name":  aws.StringValue(apiObject.Name),
+			"resource_record_type":  aws.StringValue(apiObject.Type),
+			"resource_record_value": aws.StringValue(apiObject.Value),
+		})
+	}
+
+	return tfList
+}
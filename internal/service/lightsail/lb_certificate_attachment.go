@@ -0,0 +1,100 @@
+package lightsail
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceLoadBalancerCertificateAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLoadBalancerCertificateAttachmentCreate,
+		Read:   resourceLoadBalancerCertificateAttachmentRead,
+		Delete: resourceLoadBalancerCertificateAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"certificate_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"lb_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceLoadBalancerCertificateAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+
+	lbName := d.Get("lb_name").(string)
+	certificateName := d.Get("certificate_name").(string)
+	id := LoadBalancerCertificateCreateResourceID(lbName, certificateName)
+	input := &lightsail.AttachLoadBalancerTlsCertificateInput{
+		CertificateName:  aws.String(certificateName),
+		LoadBalancerName: aws.String(lbName),
+	}
+
+	log.Printf("[DEBUG] Creating Lightsail Load Balancer Certificate Attachment: %s", input)
+	output, err := conn.AttachLoadBalancerTlsCertificate(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Lightsail Load Balancer Certificate Attachment (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	if err := waitOperations(conn, output.Operations); err != nil {
+		return fmt.Errorf("error waiting for Lightsail Load Balancer Certificate Attachment (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceLoadBalancerCertificateAttachmentRead(d, meta)
+}
+
+func resourceLoadBalancerCertificateAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+
+	lbName, certificateName, err := LoadBalancerCertificateParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	certificate, err := FindLoadBalancerCertificate(conn, lbName, certificateName)
+
+	if err == nil && !aws.BoolValue(certificate.IsAttached) {
+		err = &resource.NotFoundError{}
+	}
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lightsail Load Balancer Certificate Attachment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Load Balancer Certificate Attachment (%s): %w", d.Id(), err)
+	}
+
+	d.Set("certificate_name", certificateName)
+	d.Set("lb_name", lbName)
+
+	return nil
+}
+
+func resourceLoadBalancerCertificateAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[WARN] Cannot detach Lightsail Load Balancer Certificate. Terraform will remove this resource from the state file, however the certificate remains attached until it is deleted or another certificate is attached.")
+	return nil
+}
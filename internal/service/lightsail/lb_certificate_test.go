@@ -0,0 +1,155 @@
+package lightsail_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflightsail "github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccLightsailLoadBalancerCertificate_basic(t *testing.T) {
+	var certificate lightsail.LoadBalancerTlsCertificate
+	rName := fmt.Sprintf("tf-test-lightsail-%d", sdkacctest.RandInt())
+	domainName := acctest.RandomDomainName()
+	resourceName := "aws_lightsail_lb_certificate.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(lightsail.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, lightsail.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckLoadBalancerCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoadBalancerCertificateConfig_basic(rName, domainName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLoadBalancerCertificateExists(resourceName, &certificate),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttr(resourceName, "domain_name", domainName),
+					resource.TestCheckResourceAttr(resourceName, "domain_validation_records.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "lb_name", "aws_lightsail_lb.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "subject_alternative_names.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "subject_alternative_names.*", domainName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccLightsailLoadBalancerCertificate_disappears(t *testing.T) {
+	var certificate lightsail.LoadBalancerTlsCertificate
+	rName := fmt.Sprintf("tf-test-lightsail-%d", sdkacctest.RandInt())
+	domainName := acctest.RandomDomainName()
+	resourceName := "aws_lightsail_lb_certificate.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(lightsail.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, lightsail.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckLoadBalancerCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoadBalancerCertificateConfig_basic(rName, domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLoadBalancerCertificateExists(resourceName, &certificate),
+					acctest.CheckResourceDisappears(acctest.Provider, tflightsail.ResourceLoadBalancerCertificate(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckLoadBalancerCertificateExists(n string, certificate *lightsail.LoadBalancerTlsCertificate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lightsail Load Balancer Certificate ID is set")
+		}
+
+		lbName, name, err := tflightsail.LoadBalancerCertificateParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LightsailConn
+
+		output, err := tflightsail.FindLoadBalancerCertificate(conn, lbName, name)
+
+		if err != nil {
+			return err
+		}
+
+		*certificate = *output
+
+		return nil
+	}
+}
+
+func testAccCheckLoadBalancerCertificateDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LightsailConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lightsail_lb_certificate" {
+			continue
+		}
+
+		lbName, name, err := tflightsail.LoadBalancerCertificateParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tflightsail.FindLoadBalancerCertificate(conn, lbName, name)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Lightsail Load Balancer Certificate %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccLoadBalancerCertificateConfig_basic(rName, domainName string) string {
+	return acctest.ConfigCompose(
+		testAccLoadBalancerConfig_basic(rName, "/"),
+		fmt.Sprintf(`
+resource "aws_lightsail_lb_certificate" "test" {
+  name        = %[1]q
+  lb_name     = aws_lightsail_lb.test.name
+  domain_name = %[2]q
+}
+`, rName, domainName))
+}
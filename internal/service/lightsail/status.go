@@ -0,0 +1,82 @@
+package lightsail
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// statusOperation checks the status of an Operation, which is returned from
+// most Create/Update/Delete methods.
+func statusOperation(conn *lightsail.Lightsail, oid *string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		log.Printf("[DEBUG] Checking if Lightsail Operation (%s) is Completed", aws.StringValue(oid))
+
+		output, err := conn.GetOperation(&lightsail.GetOperationInput{
+			OperationId: oid,
+		})
+
+		if err != nil {
+			return output, "FAILED", err
+		}
+
+		if output.Operation == nil {
+			return nil, "Failed", fmt.Errorf("Error retrieving Operation info for operation (%s)", aws.StringValue(oid))
+		}
+
+		log.Printf("[DEBUG] Lightsail Operation (%s) is currently %q", aws.StringValue(oid), aws.StringValue(output.Operation.Status))
+		return output.Operation, aws.StringValue(output.Operation.Status), nil
+	}
+}
+
+func statusDatabase(conn *lightsail.Lightsail, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDatabaseByName(conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
+
+func statusContainerService(conn *lightsail.Lightsail, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindContainerServiceByName(conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
+
+func statusContainerServiceDeploymentVersion(conn *lightsail.Lightsail, serviceName string, version int) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindContainerServiceDeploymentByVersion(conn, serviceName, version)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
@@ -16,12 +16,40 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_lightsail_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lightsail_bucket", &resource.Sweeper{
+		Name: "aws_lightsail_bucket",
+		F:    sweepBuckets,
+	})
+
+	sweep.AddTestSweepers("aws_lightsail_certificate", &resource.Sweeper{
+		Name: "aws_lightsail_certificate",
+		F:    sweepCertificates,
+		Dependencies: []string{
+			"aws_lightsail_container_service",
+		},
+	})
+
+	sweep.AddTestSweepers("aws_lightsail_container_service", &resource.Sweeper{
+		Name: "aws_lightsail_container_service",
+		F:    sweepContainerServices,
+	})
+
+	sweep.AddTestSweepers("aws_lightsail_database", &resource.Sweeper{
+		Name: "aws_lightsail_database",
+		F:    sweepDatabases,
+	})
+
+	sweep.AddTestSweepers("aws_lightsail_instance", &resource.Sweeper{
 		Name: "aws_lightsail_instance",
 		F:    sweepInstances,
 	})
 
-	resource.AddTestSweepers("aws_lightsail_static_ip", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lightsail_lb", &resource.Sweeper{
+		Name: "aws_lightsail_lb",
+		F:    sweepLoadBalancers,
+	})
+
+	sweep.AddTestSweepers("aws_lightsail_static_ip", &resource.Sweeper{
 		Name: "aws_lightsail_static_ip",
 		F:    sweepStaticIPs,
 	})
@@ -119,3 +147,202 @@ func sweepStaticIPs(region string) error {
 
 	return nil
 }
+
+func sweepBuckets(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*conns.AWSClient).LightsailConn
+	input := &lightsail.GetBucketsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	for {
+		output, err := conn.GetBuckets(input)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Lightsail Bucket sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing Lightsail Buckets (%s): %w", region, err)
+		}
+
+		for _, bucket := range output.Buckets {
+			r := ResourceBucket()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(bucket.Name))
+			d.Set("force_delete", true)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		if aws.StringValue(output.NextPageToken) == "" {
+			break
+		}
+
+		input.PageToken = output.NextPageToken
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		return fmt.Errorf("error sweeping Lightsail Buckets (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepCertificates(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*conns.AWSClient).LightsailConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	output, err := conn.GetCertificates(&lightsail.GetCertificatesInput{})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Lightsail Certificate sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Lightsail Certificates (%s): %w", region, err)
+	}
+
+	for _, certificate := range output.Certificates {
+		r := ResourceCertificate()
+		d := r.Data(nil)
+		d.SetId(aws.StringValue(certificate.CertificateName))
+
+		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		return fmt.Errorf("error sweeping Lightsail Certificates (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepContainerServices(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*conns.AWSClient).LightsailConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	output, err := conn.GetContainerServices(&lightsail.GetContainerServicesInput{})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Lightsail Container Service sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Lightsail Container Services (%s): %w", region, err)
+	}
+
+	for _, cs := range output.ContainerServices {
+		r := ResourceContainerService()
+		d := r.Data(nil)
+		d.SetId(aws.StringValue(cs.ContainerServiceName))
+
+		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		return fmt.Errorf("error sweeping Lightsail Container Services (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepDatabases(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*conns.AWSClient).LightsailConn
+	input := &lightsail.GetRelationalDatabasesInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	for {
+		output, err := conn.GetRelationalDatabases(input)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Lightsail Database sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing Lightsail Databases (%s): %w", region, err)
+		}
+
+		for _, database := range output.RelationalDatabases {
+			r := ResourceDatabase()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(database.Name))
+			d.Set("skip_final_snapshot", true)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		if aws.StringValue(output.NextPageToken) == "" {
+			break
+		}
+
+		input.PageToken = output.NextPageToken
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		return fmt.Errorf("error sweeping Lightsail Databases (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepLoadBalancers(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*conns.AWSClient).LightsailConn
+	input := &lightsail.GetLoadBalancersInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	for {
+		output, err := conn.GetLoadBalancers(input)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Lightsail Load Balancer sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing Lightsail Load Balancers (%s): %w", region, err)
+		}
+
+		for _, lb := range output.LoadBalancers {
+			r := ResourceLoadBalancer()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(lb.Name))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		if aws.StringValue(output.NextPageToken) == "" {
+			break
+		}
+
+		input.PageToken = output.NextPageToken
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		return fmt.Errorf("error sweeping Lightsail Load Balancers (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,186 @@
+package lightsail
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	OperationTimeout    = 10 * time.Minute
+	OperationDelay      = 5 * time.Second
+	OperationMinTimeout = 3 * time.Second
+
+	// Lightsail relational database states are not modeled as an enum.
+	DatabaseStateAvailable   = "available"
+	DatabaseStateBackingUp   = "backing-up"
+	DatabaseStateConfiguring = "configuring-enhanced-monitoring"
+	DatabaseStateCreating    = "creating"
+	DatabaseStateModifying   = "modifying"
+	DatabaseStateRebooting   = "rebooting"
+	DatabaseStateUpdating    = "updating"
+
+	DatabaseTimeout = 20 * time.Minute
+
+	ContainerServiceCreateTimeout = 30 * time.Minute
+	ContainerServiceUpdateTimeout = 30 * time.Minute
+	ContainerServiceDeleteTimeout = 30 * time.Minute
+
+	ContainerServiceDeploymentVersionCreateTimeout = 30 * time.Minute
+)
+
+// waitOperation waits for a Lightsail Operation to reach a terminal state.
+func waitOperation(conn *lightsail.Lightsail, oid *string) error {
+	return waitOperationWithTimeout(conn, oid, OperationTimeout)
+}
+
+func waitOperationWithTimeout(conn *lightsail.Lightsail, oid *string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{lightsail.OperationStatusNotStarted, lightsail.OperationStatusStarted},
+		Target:     []string{lightsail.OperationStatusCompleted, lightsail.OperationStatusSucceeded},
+		Refresh:    statusOperation(conn, oid),
+		Timeout:    timeout,
+		Delay:      OperationDelay,
+		MinTimeout: OperationMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*lightsail.Operation); ok {
+		if aws.StringValue(output.Status) == lightsail.OperationStatusFailed {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(output.ErrorCode), aws.StringValue(output.ErrorDetails)))
+		}
+	}
+
+	return err
+}
+
+// waitOperations waits for each of the Operations returned by a Lightsail API call.
+func waitOperations(conn *lightsail.Lightsail, operations []*lightsail.Operation) error {
+	if len(operations) == 0 {
+		return errors.New("no operations found")
+	}
+
+	for _, op := range operations {
+		if err := waitOperation(conn, op.Id); err != nil {
+			return fmt.Errorf("operation (%s): %w", aws.StringValue(op.Id), err)
+		}
+	}
+
+	return nil
+}
+
+func waitDatabaseAvailable(conn *lightsail.Lightsail, name string, timeout time.Duration) (*lightsail.RelationalDatabase, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			DatabaseStateBackingUp,
+			DatabaseStateConfiguring,
+			DatabaseStateCreating,
+			DatabaseStateModifying,
+			DatabaseStateRebooting,
+			DatabaseStateUpdating,
+		},
+		Target:     []string{DatabaseStateAvailable},
+		Refresh:    statusDatabase(conn, name),
+		Timeout:    timeout,
+		Delay:      OperationDelay,
+		MinTimeout: OperationMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*lightsail.RelationalDatabase); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitContainerServiceCreated(conn *lightsail.Lightsail, name string, timeout time.Duration) (*lightsail.ContainerService, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{lightsail.ContainerServiceStatePending},
+		Target:     []string{lightsail.ContainerServiceStateReady, lightsail.ContainerServiceStateRunning},
+		Refresh:    statusContainerService(conn, name),
+		Timeout:    timeout,
+		Delay:      OperationDelay,
+		MinTimeout: OperationMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*lightsail.ContainerService); ok {
+		if detail := output.StateDetail; detail != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(detail.Code), aws.StringValue(detail.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitContainerServiceUpdated(conn *lightsail.Lightsail, name string, timeout time.Duration) (*lightsail.ContainerService, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{lightsail.ContainerServiceStateUpdating, lightsail.ContainerServiceStateDeploying},
+		Target: []string{
+			lightsail.ContainerServiceStateReady,
+			lightsail.ContainerServiceStateRunning,
+			lightsail.ContainerServiceStateDisabled,
+		},
+		Refresh:    statusContainerService(conn, name),
+		Timeout:    timeout,
+		Delay:      OperationDelay,
+		MinTimeout: OperationMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*lightsail.ContainerService); ok {
+		if detail := output.StateDetail; detail != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(detail.Code), aws.StringValue(detail.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitContainerServiceDeleted(conn *lightsail.Lightsail, name string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{lightsail.ContainerServiceStateDeleting},
+		Target:     []string{},
+		Refresh:    statusContainerService(conn, name),
+		Timeout:    timeout,
+		Delay:      OperationDelay,
+		MinTimeout: OperationMinTimeout,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitContainerServiceDeploymentVersionActive(conn *lightsail.Lightsail, serviceName string, version int, timeout time.Duration) (*lightsail.ContainerServiceDeployment, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{lightsail.ContainerServiceDeploymentStateActivating},
+		Target:     []string{lightsail.ContainerServiceDeploymentStateActive},
+		Refresh:    statusContainerServiceDeploymentVersion(conn, serviceName, version),
+		Timeout:    timeout,
+		Delay:      OperationDelay,
+		MinTimeout: OperationMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*lightsail.ContainerServiceDeployment); ok {
+		return output, err
+	}
+
+	return nil, err
+}
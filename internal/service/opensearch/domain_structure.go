@@ -0,0 +1,324 @@
+package opensearch
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/opensearchservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func expandAdvancedSecurityOptions(m []interface{}) *opensearchservice.AdvancedSecurityOptionsInput_ {
+	config := opensearchservice.AdvancedSecurityOptionsInput_{}
+	group := m[0].(map[string]interface{})
+
+	if advancedSecurityEnabled, ok := group["enabled"]; ok {
+		config.Enabled = aws.Bool(advancedSecurityEnabled.(bool))
+
+		if advancedSecurityEnabled.(bool) {
+			if v, ok := group["internal_user_database_enabled"].(bool); ok {
+				config.InternalUserDatabaseEnabled = aws.Bool(v)
+			}
+
+			if v, ok := group["master_user_options"].([]interface{}); ok {
+				if len(v) > 0 && v[0] != nil {
+					muo := opensearchservice.MasterUserOptions{}
+					masterUserOptions := v[0].(map[string]interface{})
+
+					if v, ok := masterUserOptions["master_user_arn"].(string); ok && v != "" {
+						muo.MasterUserARN = aws.String(v)
+					}
+
+					if v, ok := masterUserOptions["master_user_name"].(string); ok && v != "" {
+						muo.MasterUserName = aws.String(v)
+					}
+
+					if v, ok := masterUserOptions["master_user_password"].(string); ok && v != "" {
+						muo.MasterUserPassword = aws.String(v)
+					}
+
+					config.SetMasterUserOptions(&muo)
+				}
+			}
+		}
+	}
+
+	return &config
+}
+
+func expandAutoTuneOptions(tfMap map[string]interface{}) *opensearchservice.AutoTuneOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	options := &opensearchservice.AutoTuneOptions{}
+
+	autoTuneOptionsInput := expandAutoTuneOptionsInput(tfMap)
+
+	options.DesiredState = autoTuneOptionsInput.DesiredState
+	options.MaintenanceSchedules = autoTuneOptionsInput.MaintenanceSchedules
+
+	options.RollbackOnDisable = aws.String(tfMap["rollback_on_disable"].(string))
+
+	return options
+}
+
+func expandAutoTuneOptionsInput(tfMap map[string]interface{}) *opensearchservice.AutoTuneOptionsInput_ {
+	if tfMap == nil {
+		return nil
+	}
+
+	options := &opensearchservice.AutoTuneOptionsInput_{}
+
+	options.DesiredState = aws.String(tfMap["desired_state"].(string))
+
+	if v, ok := tfMap["maintenance_schedule"].(*schema.Set); ok && v.Len() > 0 {
+		options.MaintenanceSchedules = expandAutoTuneMaintenanceSchedules(v.List())
+	}
+
+	return options
+}
+
+func expandAutoTuneMaintenanceSchedules(tfList []interface{}) []*opensearchservice.AutoTuneMaintenanceSchedule {
+	var autoTuneMaintenanceSchedules []*opensearchservice.AutoTuneMaintenanceSchedule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, _ := tfMapRaw.(map[string]interface{})
+
+		autoTuneMaintenanceSchedule := &opensearchservice.AutoTuneMaintenanceSchedule{}
+
+		startAt, _ := time.Parse(time.RFC3339, tfMap["start_at"].(string))
+		autoTuneMaintenanceSchedule.StartAt = aws.Time(startAt)
+
+		if v, ok := tfMap["duration"].([]interface{}); ok {
+			autoTuneMaintenanceSchedule.Duration = expandAutoTuneMaintenanceScheduleDuration(v[0].(map[string]interface{}))
+		}
+
+		autoTuneMaintenanceSchedule.CronExpressionForRecurrence = aws.String(tfMap["cron_expression_for_recurrence"].(string))
+
+		autoTuneMaintenanceSchedules = append(autoTuneMaintenanceSchedules, autoTuneMaintenanceSchedule)
+	}
+
+	return autoTuneMaintenanceSchedules
+}
+
+func expandAutoTuneMaintenanceScheduleDuration(tfMap map[string]interface{}) *opensearchservice.Duration {
+	autoTuneMaintenanceScheduleDuration := &opensearchservice.Duration{
+		Value: aws.Int64(int64(tfMap["value"].(int))),
+		Unit:  aws.String(tfMap["unit"].(string)),
+	}
+
+	return autoTuneMaintenanceScheduleDuration
+}
+
+func expandESSAMLOptions(data []interface{}) *opensearchservice.SAMLOptionsInput_ {
+	if len(data) == 0 {
+		return nil
+	}
+
+	if data[0] == nil {
+		return &opensearchservice.SAMLOptionsInput_{}
+	}
+
+	options := opensearchservice.SAMLOptionsInput_{}
+	group := data[0].(map[string]interface{})
+
+	if SAMLEnabled, ok := group["enabled"]; ok {
+		options.Enabled = aws.Bool(SAMLEnabled.(bool))
+
+		if SAMLEnabled.(bool) {
+			options.Idp = expandSAMLOptionsIdp(group["idp"].([]interface{}))
+			if v, ok := group["master_backend_role"].(string); ok && v != "" {
+				options.MasterBackendRole = aws.String(v)
+			}
+			if v, ok := group["master_user_name"].(string); ok && v != "" {
+				options.MasterUserName = aws.String(v)
+			}
+			if v, ok := group["roles_key"].(string); ok {
+				options.RolesKey = aws.String(v)
+			}
+			if v, ok := group["session_timeout_minutes"].(int); ok {
+				options.SessionTimeoutMinutes = aws.Int64(int64(v))
+			}
+			if v, ok := group["subject_key"].(string); ok {
+				options.SubjectKey = aws.String(v)
+			}
+		}
+	}
+
+	return &options
+}
+
+func expandSAMLOptionsIdp(l []interface{}) *opensearchservice.SAMLIdp {
+	if len(l) == 0 {
+		return nil
+	}
+
+	if l[0] == nil {
+		return &opensearchservice.SAMLIdp{}
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &opensearchservice.SAMLIdp{
+		EntityId:        aws.String(m["entity_id"].(string)),
+		MetadataContent: aws.String(m["metadata_content"].(string)),
+	}
+}
+
+func flattenAdvancedSecurityOptions(advancedSecurityOptions *opensearchservice.AdvancedSecurityOptions) []map[string]interface{} {
+	if advancedSecurityOptions == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{}
+	m["enabled"] = aws.BoolValue(advancedSecurityOptions.Enabled)
+	if aws.BoolValue(advancedSecurityOptions.Enabled) {
+		m["internal_user_database_enabled"] = aws.BoolValue(advancedSecurityOptions.InternalUserDatabaseEnabled)
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func flattenAutoTuneOptions(autoTuneOptions *opensearchservice.AutoTuneOptions) map[string]interface{} {
+	if autoTuneOptions == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{}
+
+	m["desired_state"] = aws.StringValue(autoTuneOptions.DesiredState)
+
+	if v := autoTuneOptions.MaintenanceSchedules; v != nil {
+		m["maintenance_schedule"] = flattenAutoTuneMaintenanceSchedules(v)
+	}
+
+	m["rollback_on_disable"] = aws.StringValue(autoTuneOptions.RollbackOnDisable)
+
+	return m
+}
+
+func flattenAutoTuneMaintenanceSchedules(autoTuneMaintenanceSchedules []*opensearchservice.AutoTuneMaintenanceSchedule) []interface{} {
+	if len(autoTuneMaintenanceSchedules) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, autoTuneMaintenanceSchedule := range autoTuneMaintenanceSchedules {
+		m := map[string]interface{}{}
+
+		m["start_at"] = aws.TimeValue(autoTuneMaintenanceSchedule.StartAt).Format(time.RFC3339)
+
+		m["duration"] = []interface{}{flattenAutoTuneMaintenanceScheduleDuration(autoTuneMaintenanceSchedule.Duration)}
+
+		m["cron_expression_for_recurrence"] = aws.StringValue(autoTuneMaintenanceSchedule.CronExpressionForRecurrence)
+
+		tfList = append(tfList, m)
+	}
+
+	return tfList
+}
+
+func flattenAutoTuneMaintenanceScheduleDuration(autoTuneMaintenanceScheduleDuration *opensearchservice.Duration) map[string]interface{} {
+	m := map[string]interface{}{}
+
+	m["value"] = aws.Int64Value(autoTuneMaintenanceScheduleDuration.Value)
+	m["unit"] = aws.StringValue(autoTuneMaintenanceScheduleDuration.Unit)
+
+	return m
+}
+
+func flattenESSAMLOptions(d *schema.ResourceData, samlOptions *opensearchservice.SAMLOptionsOutput_) []interface{} {
+	if samlOptions == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{
+		"enabled": aws.BoolValue(samlOptions.Enabled),
+		"idp":     flattenESSAMLIdpOptions(samlOptions.Idp),
+	}
+
+	m["roles_key"] = aws.StringValue(samlOptions.RolesKey)
+	m["session_timeout_minutes"] = aws.Int64Value(samlOptions.SessionTimeoutMinutes)
+	m["subject_key"] = aws.StringValue(samlOptions.SubjectKey)
+
+	// samlOptions.master_backend_role and samlOptions.master_user_name will be added to the
+	// all_access role in kibana's security manager.  These values cannot be read or
+	// modified by the OpenSearch API.  So, we ignore it on read and let persist
+	// the value already in the state.
+	m["master_backend_role"] = d.Get("saml_options.0.master_backend_role").(string)
+	m["master_user_name"] = d.Get("saml_options.0.master_user_name").(string)
+
+	return []interface{}{m}
+}
+
+func flattenESSAMLIdpOptions(SAMLIdp *opensearchservice.SAMLIdp) []interface{} {
+	if SAMLIdp == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"entity_id":        aws.StringValue(SAMLIdp.EntityId),
+		"metadata_content": aws.StringValue(SAMLIdp.MetadataContent),
+	}
+
+	return []interface{}{m}
+}
+
+func getMasterUserOptions(d *schema.ResourceData) []interface{} {
+	if v, ok := d.GetOk("advanced_security_options"); ok {
+		options := v.([]interface{})
+		if len(options) > 0 && options[0] != nil {
+			m := options[0].(map[string]interface{})
+			if opts, ok := m["master_user_options"]; ok {
+				return opts.([]interface{})
+			}
+		}
+	}
+	return []interface{}{}
+}
+
+func getUserDBEnabled(d *schema.ResourceData) bool {
+	if v, ok := d.GetOk("advanced_security_options"); ok {
+		options := v.([]interface{})
+		if len(options) > 0 && options[0] != nil {
+			m := options[0].(map[string]interface{})
+			if enabled, ok := m["internal_user_database_enabled"]; ok {
+				return enabled.(bool)
+			}
+		}
+	}
+	return false
+}
+
+func expandLogPublishingOptions(m *schema.Set) map[string]*opensearchservice.LogPublishingOption {
+	options := make(map[string]*opensearchservice.LogPublishingOption)
+
+	for _, vv := range m.List() {
+		lo := vv.(map[string]interface{})
+		options[lo["log_type"].(string)] = &opensearchservice.LogPublishingOption{
+			CloudWatchLogsLogGroupArn: aws.String(lo["cloudwatch_log_group_arn"].(string)),
+			Enabled:                   aws.Bool(lo["enabled"].(bool)),
+		}
+	}
+
+	return options
+}
+
+func flattenLogPublishingOptions(o map[string]*opensearchservice.LogPublishingOption) []map[string]interface{} {
+	m := make([]map[string]interface{}, 0)
+	for logType, val := range o {
+		mm := map[string]interface{}{
+			"log_type": logType,
+			"enabled":  aws.BoolValue(val.Enabled),
+		}
+
+		if val.CloudWatchLogsLogGroupArn != nil {
+			mm["cloudwatch_log_group_arn"] = aws.StringValue(val.CloudWatchLogsLogGroupArn)
+		}
+
+		m = append(m, mm)
+	}
+	return m
+}
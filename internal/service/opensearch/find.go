@@ -0,0 +1,199 @@
+package opensearch
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/opensearchservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindDomainByName(conn *opensearchservice.OpenSearchService, name string) (*opensearchservice.DomainStatus, error) {
+	input := &opensearchservice.DescribeDomainInput{
+		DomainName: aws.String(name),
+	}
+
+	output, err := conn.DescribeDomain(input)
+	if tfawserr.ErrCodeEquals(err, opensearchservice.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DomainStatus == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.DomainStatus, nil
+}
+
+func FindOutboundConnectionByID(conn *opensearchservice.OpenSearchService, id string) (*opensearchservice.OutboundConnection, error) {
+	input := &opensearchservice.DescribeOutboundConnectionsInput{
+		Filters: []*opensearchservice.Filter{
+			{
+				Name:   aws.String("connection-id"),
+				Values: aws.StringSlice([]string{id}),
+			},
+		},
+	}
+
+	output, err := conn.DescribeOutboundConnections(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Connections) == 0 || output.Connections[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.Connections); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	connection := output.Connections[0]
+
+	if connection.ConnectionStatus == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := aws.StringValue(connection.ConnectionStatus.StatusCode); status == opensearchservice.OutboundConnectionStatusCodeDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return connection, nil
+}
+
+func FindInboundConnectionByID(conn *opensearchservice.OpenSearchService, id string) (*opensearchservice.InboundConnection, error) {
+	input := &opensearchservice.DescribeInboundConnectionsInput{
+		Filters: []*opensearchservice.Filter{
+			{
+				Name:   aws.String("connection-id"),
+				Values: aws.StringSlice([]string{id}),
+			},
+		},
+	}
+
+	output, err := conn.DescribeInboundConnections(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Connections) == 0 || output.Connections[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.Connections); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	connection := output.Connections[0]
+
+	if connection.ConnectionStatus == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	switch status := aws.StringValue(connection.ConnectionStatus.StatusCode); status {
+	case opensearchservice.InboundConnectionStatusCodeDeleted, opensearchservice.InboundConnectionStatusCodeRejected:
+		return nil, &resource.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return connection, nil
+}
+
+func FindPackageByID(conn *opensearchservice.OpenSearchService, id string) (*opensearchservice.PackageDetails, error) {
+	input := &opensearchservice.DescribePackagesInput{
+		Filters: []*opensearchservice.DescribePackagesFilter{
+			{
+				Name:  aws.String(opensearchservice.DescribePackagesFilterNamePackageId),
+				Value: aws.StringSlice([]string{id}),
+			},
+		},
+	}
+
+	output, err := conn.DescribePackages(input)
+
+	if tfawserr.ErrCodeEquals(err, opensearchservice.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.PackageDetailsList) == 0 || output.PackageDetailsList[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.PackageDetailsList); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	pkg := output.PackageDetailsList[0]
+
+	if status := aws.StringValue(pkg.PackageStatus); status == opensearchservice.PackageStatusDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return pkg, nil
+}
+
+func FindPackageAssociationByTwoPartKey(conn *opensearchservice.OpenSearchService, domainName, packageID string) (*opensearchservice.DomainPackageDetails, error) {
+	input := &opensearchservice.ListDomainsForPackageInput{
+		PackageID: aws.String(packageID),
+	}
+	var result *opensearchservice.DomainPackageDetails
+
+	err := conn.ListDomainsForPackagePages(input, func(page *opensearchservice.ListDomainsForPackageOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DomainPackageDetailsList {
+			if v != nil && aws.StringValue(v.DomainName) == domainName {
+				result = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, opensearchservice.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return result, nil
+}
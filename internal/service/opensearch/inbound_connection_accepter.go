@@ -0,0 +1,111 @@
+package opensearch
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/opensearchservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceInboundConnectionAccepter() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceInboundConnectionAccepterCreate,
+		Read:   resourceInboundConnectionAccepterRead,
+		Delete: resourceInboundConnectionAccepterDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("connection_id", d.Id())
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"connection_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(10, 256),
+			},
+			"connection_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceInboundConnectionAccepterCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).OpenSearchConn
+
+	connectionID := d.Get("connection_id").(string)
+	input := &opensearchservice.AcceptInboundConnectionInput{
+		ConnectionId: aws.String(connectionID),
+	}
+
+	log.Printf("[DEBUG] Accepting OpenSearch Inbound Connection: %s", input)
+	_, err := conn.AcceptInboundConnection(input)
+
+	if err != nil {
+		return fmt.Errorf("error accepting OpenSearch Inbound Connection (%s): %w", connectionID, err)
+	}
+
+	d.SetId(connectionID)
+
+	if _, err := waitInboundConnectionAccepted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for OpenSearch Inbound Connection (%s) accept: %w", d.Id(), err)
+	}
+
+	return resourceInboundConnectionAccepterRead(d, meta)
+}
+
+func resourceInboundConnectionAccepterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).OpenSearchConn
+
+	connection, err := FindInboundConnectionByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] OpenSearch Inbound Connection (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading OpenSearch Inbound Connection (%s): %w", d.Id(), err)
+	}
+
+	d.Set("connection_id", connection.ConnectionId)
+	d.Set("connection_status", connection.ConnectionStatus.StatusCode)
+
+	return nil
+}
+
+func resourceInboundConnectionAccepterDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).OpenSearchConn
+
+	log.Printf("[DEBUG] Deleting OpenSearch Inbound Connection: %s", d.Id())
+	_, err := conn.DeleteInboundConnection(&opensearchservice.DeleteInboundConnectionInput{
+		ConnectionId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, opensearchservice.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting OpenSearch Inbound Connection (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitInboundConnectionDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for OpenSearch Inbound Connection (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package opensearch_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/opensearchservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfopensearch "github.com/hashicorp/terraform-provider-aws/internal/service/opensearch"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccOpenSearchInboundConnectionAccepter_basic(t *testing.T) {
+	var connection opensearchservice.InboundConnection
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_opensearch_inbound_connection_accepter.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckIamServiceLinkedRoleEs(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, opensearchservice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckInboundConnectionAccepterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInboundConnectionAccepterConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInboundConnectionAccepterExists(resourceName, &connection),
+					resource.TestCheckResourceAttrPair(resourceName, "connection_id", "aws_opensearch_outbound_connection.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "connection_status", opensearchservice.InboundConnectionStatusCodeActive),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchInboundConnectionAccepter_disappears(t *testing.T) {
+	var connection opensearchservice.InboundConnection
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_opensearch_inbound_connection_accepter.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckIamServiceLinkedRoleEs(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, opensearchservice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckInboundConnectionAccepterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInboundConnectionAccepterConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInboundConnectionAccepterExists(resourceName, &connection),
+					acctest.CheckResourceDisappears(acctest.Provider, tfopensearch.ResourceInboundConnectionAccepter(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckInboundConnectionAccepterExists(n string, v *opensearchservice.InboundConnection) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No OpenSearch Inbound Connection ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchConn
+
+		output, err := tfopensearch.FindInboundConnectionByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckInboundConnectionAccepterDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_opensearch_inbound_connection_accepter" {
+			continue
+		}
+
+		_, err := tfopensearch.FindInboundConnectionByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("OpenSearch Inbound Connection %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccInboundConnectionAccepterConfig(rName string) string {
+	return acctest.ConfigCompose(testAccOutboundConnectionConfig(rName), `
+resource "aws_opensearch_inbound_connection_accepter" "test" {
+  connection_id = aws_opensearch_outbound_connection.test.id
+}
+`)
+}
@@ -0,0 +1,189 @@
+package opensearch
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/opensearchservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceOutboundConnection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceOutboundConnectionCreate,
+		Read:   resourceOutboundConnectionRead,
+		Delete: resourceOutboundConnectionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"connection_alias": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(2, 100),
+			},
+			"connection_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"local_domain_info":  outboundConnectionDomainInfoSchema(),
+			"remote_domain_info": outboundConnectionDomainInfoSchema(),
+		},
+	}
+}
+
+func outboundConnectionDomainInfoSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"domain_name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringLenBetween(3, 28),
+				},
+				"owner_id": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: verify.ValidAccountID,
+				},
+				"region": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceOutboundConnectionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).OpenSearchConn
+
+	connectionAlias := d.Get("connection_alias").(string)
+	input := &opensearchservice.CreateOutboundConnectionInput{
+		ConnectionAlias:  aws.String(connectionAlias),
+		LocalDomainInfo:  expandOutboundConnectionDomainInfo(d.Get("local_domain_info").([]interface{})),
+		RemoteDomainInfo: expandOutboundConnectionDomainInfo(d.Get("remote_domain_info").([]interface{})),
+	}
+
+	log.Printf("[DEBUG] Creating OpenSearch Outbound Connection: %s", input)
+	output, err := conn.CreateOutboundConnection(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating OpenSearch Outbound Connection (%s): %w", connectionAlias, err)
+	}
+
+	d.SetId(aws.StringValue(output.ConnectionId))
+
+	if _, err := waitOutboundConnectionCreated(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for OpenSearch Outbound Connection (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceOutboundConnectionRead(d, meta)
+}
+
+func resourceOutboundConnectionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).OpenSearchConn
+
+	connection, err := FindOutboundConnectionByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] OpenSearch Outbound Connection (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading OpenSearch Outbound Connection (%s): %w", d.Id(), err)
+	}
+
+	d.Set("connection_alias", connection.ConnectionAlias)
+	d.Set("connection_status", connection.ConnectionStatus.StatusCode)
+
+	if err := d.Set("local_domain_info", flattenOutboundConnectionDomainInfo(connection.LocalDomainInfo)); err != nil {
+		return fmt.Errorf("error setting local_domain_info: %w", err)
+	}
+
+	if err := d.Set("remote_domain_info", flattenOutboundConnectionDomainInfo(connection.RemoteDomainInfo)); err != nil {
+		return fmt.Errorf("error setting remote_domain_info: %w", err)
+	}
+
+	return nil
+}
+
+func resourceOutboundConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).OpenSearchConn
+
+	log.Printf("[DEBUG] Deleting OpenSearch Outbound Connection: %s", d.Id())
+	_, err := conn.DeleteOutboundConnection(&opensearchservice.DeleteOutboundConnectionInput{
+		ConnectionId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, opensearchservice.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting OpenSearch Outbound Connection (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitOutboundConnectionDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for OpenSearch Outbound Connection (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandOutboundConnectionDomainInfo(tfList []interface{}) *opensearchservice.DomainInformationContainer {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &opensearchservice.AWSDomainInformation{}
+
+	if v, ok := tfMap["domain_name"].(string); ok && v != "" {
+		apiObject.DomainName = aws.String(v)
+	}
+
+	if v, ok := tfMap["owner_id"].(string); ok && v != "" {
+		apiObject.OwnerId = aws.String(v)
+	}
+
+	if v, ok := tfMap["region"].(string); ok && v != "" {
+		apiObject.Region = aws.String(v)
+	}
+
+	return &opensearchservice.DomainInformationContainer{
+		AWSDomainInformation: apiObject,
+	}
+}
+
+func flattenOutboundConnectionDomainInfo(apiObject *opensearchservice.DomainInformationContainer) []interface{} {
+	if apiObject == nil || apiObject.AWSDomainInformation == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"domain_name": aws.StringValue(apiObject.AWSDomainInformation.DomainName),
+			"owner_id":    aws.StringValue(apiObject.AWSDomainInformation.OwnerId),
+			"region":      aws.StringValue(apiObject.AWSDomainInformation.Region),
+		},
+	}
+}
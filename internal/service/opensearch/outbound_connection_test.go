@@ -0,0 +1,177 @@
+package opensearch_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/opensearchservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfopensearch "github.com/hashicorp/terraform-provider-aws/internal/service/opensearch"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccOpenSearchOutboundConnection_basic(t *testing.T) {
+	var connection opensearchservice.OutboundConnection
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_opensearch_outbound_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckIamServiceLinkedRoleEs(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, opensearchservice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckOutboundConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOutboundConnectionConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOutboundConnectionExists(resourceName, &connection),
+					resource.TestCheckResourceAttr(resourceName, "connection_alias", rName),
+					resource.TestCheckResourceAttr(resourceName, "connection_status", opensearchservice.OutboundConnectionStatusCodePendingAcceptance),
+					resource.TestCheckResourceAttr(resourceName, "local_domain_info.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "local_domain_info.0.domain_name", "aws_opensearch_domain.local", "domain_name"),
+					resource.TestCheckResourceAttr(resourceName, "remote_domain_info.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "remote_domain_info.0.domain_name", "aws_opensearch_domain.remote", "domain_name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The remote owner may have accepted the connection in the meantime.
+				ImportStateVerifyIgnore: []string{"connection_status"},
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchOutboundConnection_disappears(t *testing.T) {
+	var connection opensearchservice.OutboundConnection
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_opensearch_outbound_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckIamServiceLinkedRoleEs(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, opensearchservice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckOutboundConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOutboundConnectionConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOutboundConnectionExists(resourceName, &connection),
+					acctest.CheckResourceDisappears(acctest.Provider, tfopensearch.ResourceOutboundConnection(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckOutboundConnectionExists(n string, v *opensearchservice.OutboundConnection) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No OpenSearch Outbound Connection ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchConn
+
+		output, err := tfopensearch.FindOutboundConnectionByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckOutboundConnectionDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_opensearch_outbound_connection" {
+			continue
+		}
+
+		_, err := tfopensearch.FindOutboundConnectionByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("OpenSearch Outbound Connection %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccOutboundConnectionBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_opensearch_domain" "local" {
+  domain_name    = "${substr(%[1]q, 0, 26)}-l"
+  engine_version = "OpenSearch_1.1"
+
+  cluster_config {
+    instance_type = "t3.small.search"
+  }
+
+  ebs_options {
+    ebs_enabled = true
+    volume_size = 10
+  }
+}
+
+resource "aws_opensearch_domain" "remote" {
+  domain_name    = "${substr(%[1]q, 0, 26)}-r"
+  engine_version = "OpenSearch_1.1"
+
+  cluster_config {
+    instance_type = "t3.small.search"
+  }
+
+  ebs_options {
+    ebs_enabled = true
+    volume_size = 10
+  }
+}
+`, rName)
+}
+
+func testAccOutboundConnectionConfig(rName string) string {
+	return acctest.ConfigCompose(testAccOutboundConnectionBaseConfig(rName), fmt.Sprintf(`
+resource "aws_opensearch_outbound_connection" "test" {
+  connection_alias = %[1]q
+
+  local_domain_info {
+    owner_id    = data.aws_caller_identity.current.account_id
+    region      = data.aws_region.current.name
+    domain_name = aws_opensearch_domain.local.domain_name
+  }
+
+  remote_domain_info {
+    owner_id    = data.aws_caller_identity.current.account_id
+    region      = data.aws_region.current.name
+    domain_name = aws_opensearch_domain.remote.domain_name
+  }
+}
+`, rName))
+}
@@ -0,0 +1,147 @@
+package opensearch
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/opensearchservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourcePackageAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePackageAssociationCreate,
+		Read:   resourcePackageAssociationRead,
+		Delete: resourcePackageAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"package_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"reference_path": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourcePackageAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).OpenSearchConn
+
+	domainName := d.Get("domain_name").(string)
+	packageID := d.Get("package_id").(string)
+	id := PackageAssociationCreateResourceID(domainName, packageID)
+	input := &opensearchservice.AssociatePackageInput{
+		DomainName: aws.String(domainName),
+		PackageID:  aws.String(packageID),
+	}
+
+	log.Printf("[DEBUG] Creating OpenSearch Package Association: %s", input)
+	_, err := conn.AssociatePackage(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating OpenSearch Package Association (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitPackageAssociationCreated(conn, domainName, packageID); err != nil {
+		return fmt.Errorf("error waiting for OpenSearch Package Association (%s) create: %w", d.Id(), err)
+	}
+
+	return resourcePackageAssociationRead(d, meta)
+}
+
+func resourcePackageAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).OpenSearchConn
+
+	domainName, packageID, err := PackageAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	association, err := FindPackageAssociationByTwoPartKey(conn, domainName, packageID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] OpenSearch Package Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading OpenSearch Package Association (%s): %w", d.Id(), err)
+	}
+
+	d.Set("domain_name", association.DomainName)
+	d.Set("package_id", association.PackageID)
+	d.Set("reference_path", association.ReferencePath)
+
+	return nil
+}
+
+func resourcePackageAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).OpenSearchConn
+
+	domainName, packageID, err := PackageAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting OpenSearch Package Association: %s", d.Id())
+	_, err = conn.DissociatePackage(&opensearchservice.DissociatePackageInput{
+		DomainName: aws.String(domainName),
+		PackageID:  aws.String(packageID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, opensearchservice.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting OpenSearch Package Association (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitPackageAssociationDeleted(conn, domainName, packageID); err != nil {
+		return fmt.Errorf("error waiting for OpenSearch Package Association (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+const packageAssociationResourceIDSeparator = ":"
+
+func PackageAssociationCreateResourceID(domainName, packageID string) string {
+	parts := []string{domainName, packageID}
+	id := strings.Join(parts, packageAssociationResourceIDSeparator)
+
+	return id
+}
+
+func PackageAssociationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, packageAssociationResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected DomainName%[2]sPackageID", id, packageAssociationResourceIDSeparator)
+}
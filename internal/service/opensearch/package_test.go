@@ -0,0 +1,156 @@
+package opensearch_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/opensearchservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfopensearch "github.com/hashicorp/terraform-provider-aws/internal/service/opensearch"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccOpenSearchPackage_basic(t *testing.T) {
+	var pkg opensearchservice.PackageDetails
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_opensearch_package.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, opensearchservice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPackageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageConfig(rName, "synonyms"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageExists(resourceName, &pkg),
+					resource.TestCheckResourceAttrSet(resourceName, "available_package_version"),
+					resource.TestCheckResourceAttr(resourceName, "package_description", "synonyms"),
+					resource.TestCheckResourceAttrPair(resourceName, "package_id", resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "package_name", rName[:28]),
+					resource.TestCheckResourceAttr(resourceName, "package_source.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "package_type", opensearchservice.PackageTypeTxtDictionary),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"package_source"},
+			},
+			{
+				Config: testAccPackageConfig(rName, "updated synonyms"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageExists(resourceName, &pkg),
+					resource.TestCheckResourceAttr(resourceName, "package_description", "updated synonyms"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchPackage_disappears(t *testing.T) {
+	var pkg opensearchservice.PackageDetails
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_opensearch_package.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, opensearchservice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPackageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageConfig(rName, "synonyms"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageExists(resourceName, &pkg),
+					acctest.CheckResourceDisappears(acctest.Provider, tfopensearch.ResourcePackage(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPackageExists(n string, v *opensearchservice.PackageDetails) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No OpenSearch Package ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchConn
+
+		output, err := tfopensearch.FindPackageByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckPackageDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_opensearch_package" {
+			continue
+		}
+
+		_, err := tfopensearch.FindPackageByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("OpenSearch Package %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccPackageBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "synonyms.txt"
+  content = "danish, croissant, pastry"
+}
+`, rName)
+}
+
+func testAccPackageConfig(rName, description string) string {
+	return acctest.ConfigCompose(testAccPackageBaseConfig(rName), fmt.Sprintf(`
+resource "aws_opensearch_package" "test" {
+  package_name        = substr(%[1]q, 0, 28)
+  package_description = %[2]q
+  package_type        = "TXT-DICTIONARY"
+
+  package_source {
+    s3_bucket_name = aws_s3_bucket.test.bucket
+    s3_key         = aws_s3_object.test.key
+  }
+}
+`, rName, description))
+}
@@ -0,0 +1,326 @@
+package opensearch
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/opensearchservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	domainUpgradeSuccessMinTimeout = 10 * time.Second
+	domainUpgradeSuccessDelay      = 30 * time.Second
+	domainRetryTimeout             = 60 * time.Minute
+	domainDeleteRetryTimeout       = 90 * time.Minute
+)
+
+// UpgradeSucceeded waits for an Upgrade to return Success
+func waitUpgradeSucceeded(conn *opensearchservice.OpenSearchService, name string, timeout time.Duration) (*opensearchservice.GetUpgradeStatusOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{opensearchservice.UpgradeStatusInProgress},
+		Target:     []string{opensearchservice.UpgradeStatusSucceeded},
+		Refresh:    statusUpgradeStatus(conn, name),
+		Timeout:    timeout,
+		MinTimeout: domainUpgradeSuccessMinTimeout,
+		Delay:      domainUpgradeSuccessDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*opensearchservice.GetUpgradeStatusOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitForDomainCreation(conn *opensearchservice.OpenSearchService, domainName string) error {
+	var out *opensearchservice.DomainStatus
+	err := resource.Retry(domainRetryTimeout, func() *resource.RetryError {
+		var err error
+		out, err = FindDomainByName(conn, domainName)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if !aws.BoolValue(out.Processing) && (out.Endpoint != nil || out.Endpoints != nil) {
+			return nil
+		}
+
+		return resource.RetryableError(
+			fmt.Errorf("%q: Timeout while waiting for the domain to be created", domainName))
+	})
+	if tfresource.TimedOut(err) {
+		out, err = FindDomainByName(conn, domainName)
+		if err != nil {
+			return fmt.Errorf("Error describing OpenSearch domain: %w", err)
+		}
+		if !aws.BoolValue(out.Processing) && (out.Endpoint != nil || out.Endpoints != nil) {
+			return nil
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("Error waiting for OpenSearch domain to be created: %w", err)
+	}
+	return nil
+}
+
+func waitForDomainUpdate(conn *opensearchservice.OpenSearchService, domainName string) error {
+	var out *opensearchservice.DomainStatus
+	err := resource.Retry(domainRetryTimeout, func() *resource.RetryError {
+		var err error
+		out, err = FindDomainByName(conn, domainName)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if !aws.BoolValue(out.Processing) {
+			return nil
+		}
+
+		return resource.RetryableError(
+			fmt.Errorf("%q: Timeout while waiting for changes to be processed", domainName))
+	})
+	if tfresource.TimedOut(err) {
+		out, err = FindDomainByName(conn, domainName)
+		if err != nil {
+			return fmt.Errorf("Error describing OpenSearch domain: %w", err)
+		}
+		if !aws.BoolValue(out.Processing) {
+			return nil
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("Error waiting for OpenSearch domain changes to be processed: %w", err)
+	}
+	return nil
+}
+
+func waitForDomainDelete(conn *opensearchservice.OpenSearchService, domainName string) error {
+	var out *opensearchservice.DomainStatus
+	err := resource.Retry(domainDeleteRetryTimeout, func() *resource.RetryError {
+		var err error
+		out, err = FindDomainByName(conn, domainName)
+
+		if err != nil {
+			if tfresource.NotFound(err) {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		if out != nil && !aws.BoolValue(out.Processing) {
+			return nil
+		}
+
+		return resource.RetryableError(fmt.Errorf("timeout while waiting for the domain %q to be deleted", domainName))
+	})
+	if tfresource.TimedOut(err) {
+		out, err = FindDomainByName(conn, domainName)
+		if err != nil {
+			if tfresource.NotFound(err) {
+				return nil
+			}
+			return fmt.Errorf("Error describing OpenSearch domain: %s", err)
+		}
+		if out != nil && !aws.BoolValue(out.Processing) {
+			return nil
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("Error waiting for OpenSearch domain to be deleted: %s", err)
+	}
+	return nil
+}
+
+const (
+	connectionCreatedTimeout         = 5 * time.Minute
+	connectionDeletedTimeout         = 5 * time.Minute
+	packageAvailableTimeout          = 20 * time.Minute
+	packageDeletedTimeout            = 20 * time.Minute
+	packageAssociationCreatedTimeout = 10 * time.Minute
+	packageAssociationDeletedTimeout = 10 * time.Minute
+)
+
+// waitOutboundConnectionCreated waits for an outbound connection to be validated and sent to the remote domain owner.
+func waitOutboundConnectionCreated(conn *opensearchservice.OpenSearchService, id string) (*opensearchservice.OutboundConnection, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			opensearchservice.OutboundConnectionStatusCodeValidating,
+			opensearchservice.OutboundConnectionStatusCodeProvisioning,
+		},
+		Target: []string{
+			opensearchservice.OutboundConnectionStatusCodePendingAcceptance,
+			opensearchservice.OutboundConnectionStatusCodeApproved,
+			opensearchservice.OutboundConnectionStatusCodeActive,
+		},
+		Refresh: statusOutboundConnection(conn, id),
+		Timeout: connectionCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*opensearchservice.OutboundConnection); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.ConnectionStatus.Message)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitOutboundConnectionDeleted(conn *opensearchservice.OpenSearchService, id string) (*opensearchservice.OutboundConnection, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{opensearchservice.OutboundConnectionStatusCodeDeleting},
+		Target:  []string{},
+		Refresh: statusOutboundConnection(conn, id),
+		Timeout: connectionDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*opensearchservice.OutboundConnection); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.ConnectionStatus.Message)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitInboundConnectionAccepted(conn *opensearchservice.OpenSearchService, id string) (*opensearchservice.InboundConnection, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			opensearchservice.InboundConnectionStatusCodePendingAcceptance,
+			opensearchservice.InboundConnectionStatusCodeApproved,
+			opensearchservice.InboundConnectionStatusCodeProvisioning,
+		},
+		Target:  []string{opensearchservice.InboundConnectionStatusCodeActive},
+		Refresh: statusInboundConnection(conn, id),
+		Timeout: connectionCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*opensearchservice.InboundConnection); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.ConnectionStatus.Message)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitInboundConnectionDeleted(conn *opensearchservice.OpenSearchService, id string) (*opensearchservice.InboundConnection, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			opensearchservice.InboundConnectionStatusCodeDeleting,
+			opensearchservice.InboundConnectionStatusCodeRejecting,
+		},
+		Target:  []string{},
+		Refresh: statusInboundConnection(conn, id),
+		Timeout: connectionDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*opensearchservice.InboundConnection); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.ConnectionStatus.Message)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// waitPackageAvailable waits for a package to be copied from S3 and validated.
+func waitPackageAvailable(conn *opensearchservice.OpenSearchService, id string) (*opensearchservice.PackageDetails, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			opensearchservice.PackageStatusCopying,
+			opensearchservice.PackageStatusValidating,
+		},
+		Target:  []string{opensearchservice.PackageStatusAvailable},
+		Refresh: statusPackage(conn, id),
+		Timeout: packageAvailableTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*opensearchservice.PackageDetails); ok {
+		if output.ErrorDetails != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(output.ErrorDetails.ErrorType), aws.StringValue(output.ErrorDetails.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPackageDeleted(conn *opensearchservice.OpenSearchService, id string) (*opensearchservice.PackageDetails, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{opensearchservice.PackageStatusDeleting},
+		Target:  []string{},
+		Refresh: statusPackage(conn, id),
+		Timeout: packageDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*opensearchservice.PackageDetails); ok {
+		if output.ErrorDetails != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(output.ErrorDetails.ErrorType), aws.StringValue(output.ErrorDetails.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPackageAssociationCreated(conn *opensearchservice.OpenSearchService, domainName, packageID string) (*opensearchservice.DomainPackageDetails, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{opensearchservice.DomainPackageStatusAssociating},
+		Target:  []string{opensearchservice.DomainPackageStatusActive},
+		Refresh: statusPackageAssociation(conn, domainName, packageID),
+		Timeout: packageAssociationCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*opensearchservice.DomainPackageDetails); ok {
+		if output.ErrorDetails != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(output.ErrorDetails.ErrorType), aws.StringValue(output.ErrorDetails.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPackageAssociationDeleted(conn *opensearchservice.OpenSearchService, domainName, packageID string) (*opensearchservice.DomainPackageDetails, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{opensearchservice.DomainPackageStatusDissociating},
+		Target:  []string{},
+		Refresh: statusPackageAssociation(conn, domainName, packageID),
+		Timeout: packageAssociationDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*opensearchservice.DomainPackageDetails); ok {
+		if output.ErrorDetails != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(output.ErrorDetails.ErrorType), aws.StringValue(output.ErrorDetails.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,341 @@
+package quicksight
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceAnalysis() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAnalysisCreate,
+		ReadWithoutTimeout:   resourceAnalysisRead,
+		UpdateWithoutTimeout: resourceAnalysisUpdate,
+		DeleteWithoutTimeout: resourceAnalysisDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"analysis_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"aws_account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"last_updated_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+
+			"parameters": parametersSchema(),
+
+			"permission": permissionsSchema(),
+
+			"recovery_window_in_days": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  30,
+				ValidateFunc: validation.Any(
+					validation.IntBetween(7, 30),
+					validation.IntInSlice([]int{0}),
+				),
+			},
+
+			"source_entity": sourceTemplateSchema(),
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tftags.TagsSchema(),
+
+			"tags_all": tftags.TagsSchemaComputed(),
+
+			"theme_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceAnalysisCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	awsAccountId := meta.(*conns.AWSClient).AccountID
+	analysisId := d.Get("analysis_id").(string)
+
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountId = v.(string)
+	}
+
+	templateArn, dataSetReferences := expandQuickSightSourceTemplate(d.Get("source_entity").([]interface{}))
+	input := &quicksight.CreateAnalysisInput{
+		AnalysisId:   aws.String(analysisId),
+		AwsAccountId: aws.String(awsAccountId),
+		Name:         aws.String(d.Get("name").(string)),
+		SourceEntity: &quicksight.AnalysisSourceEntity{
+			SourceTemplate: &quicksight.AnalysisSourceTemplate{
+				Arn:               templateArn,
+				DataSetReferences: dataSetReferences,
+			},
+		},
+	}
+
+	if v, ok := d.GetOk("parameters"); ok && len(v.([]interface{})) > 0 {
+		input.Parameters = expandQuickSightParameters(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("permission"); ok && v.(*schema.Set).Len() > 0 {
+		input.Permissions = expandQuickSightDataSourcePermissions(v.(*schema.Set).List())
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	if v, ok := d.GetOk("theme_arn"); ok {
+		input.ThemeArn = aws.String(v.(string))
+	}
+
+	_, err := conn.CreateAnalysisWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating QuickSight Analysis (%s): %s", analysisId, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", awsAccountId, analysisId))
+
+	if _, err := waitAnalysisCreated(ctx, conn, awsAccountId, analysisId); err != nil {
+		return diag.Errorf("error waiting for QuickSight Analysis (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceAnalysisRead(ctx, d, meta)
+}
+
+func resourceAnalysisRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	awsAccountId, analysisId, err := ParseAnalysisID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	analysis, err := FindAnalysisByID(ctx, conn, awsAccountId, analysisId)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] QuickSight Analysis (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading QuickSight Analysis (%s): %s", d.Id(), err)
+	}
+
+	d.Set("analysis_id", analysis.AnalysisId)
+	d.Set("arn", analysis.Arn)
+	d.Set("aws_account_id", awsAccountId)
+	d.Set("created_time", aws.TimeValue(analysis.CreatedTime).Format(time.RFC3339))
+	d.Set("last_updated_time", aws.TimeValue(analysis.LastUpdatedTime).Format(time.RFC3339))
+	d.Set("name", analysis.Name)
+	d.Set("status", analysis.Status)
+	d.Set("theme_arn", analysis.ThemeArn)
+
+	tags, err := ListTags(conn, d.Get("arn").(string))
+
+	if err != nil {
+		return diag.Errorf("error listing tags for QuickSight Analysis (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	permsResp, err := conn.DescribeAnalysisPermissionsWithContext(ctx, &quicksight.DescribeAnalysisPermissionsInput{
+		AnalysisId:   aws.String(analysisId),
+		AwsAccountId: aws.String(awsAccountId),
+	})
+
+	if err != nil {
+		return diag.Errorf("error describing QuickSight Analysis (%s) Permissions: %s", d.Id(), err)
+	}
+
+	if err := d.Set("permission", flattenQuickSightPermissions(permsResp.Permissions)); err != nil {
+		return diag.Errorf("error setting permission: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAnalysisUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn
+
+	awsAccountId, analysisId, err := ParseAnalysisID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChangesExcept("permission", "recovery_window_in_days", "tags", "tags_all") {
+		templateArn, dataSetReferences := expandQuickSightSourceTemplate(d.Get("source_entity").([]interface{}))
+		input := &quicksight.UpdateAnalysisInput{
+			AnalysisId:   aws.String(analysisId),
+			AwsAccountId: aws.String(awsAccountId),
+			Name:         aws.String(d.Get("name").(string)),
+			Parameters:   expandQuickSightParameters(d.Get("parameters").([]interface{})),
+			SourceEntity: &quicksight.AnalysisSourceEntity{
+				SourceTemplate: &quicksight.AnalysisSourceTemplate{
+					Arn:               templateArn,
+					DataSetReferences: dataSetReferences,
+				},
+			},
+		}
+
+		if v, ok := d.GetOk("theme_arn"); ok {
+			input.ThemeArn = aws.String(v.(string))
+		}
+
+		_, err := conn.UpdateAnalysisWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating QuickSight Analysis (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitAnalysisUpdated(ctx, conn, awsAccountId, analysisId); err != nil {
+			return diag.Errorf("error waiting for QuickSight Analysis (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("permission") {
+		o, n := d.GetChange("permission")
+		toGrant, toRevoke := DiffPermissions(o.(*schema.Set).List(), n.(*schema.Set).List())
+
+		input := &quicksight.UpdateAnalysisPermissionsInput{
+			AnalysisId:   aws.String(analysisId),
+			AwsAccountId: aws.String(awsAccountId),
+		}
+
+		if len(toGrant) > 0 {
+			input.GrantPermissions = toGrant
+		}
+
+		if len(toRevoke) > 0 {
+			input.RevokePermissions = toRevoke
+		}
+
+		_, err = conn.UpdateAnalysisPermissionsWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating QuickSight Analysis (%s) permissions: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating QuickSight Analysis (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAnalysisRead(ctx, d, meta)
+}
+
+func resourceAnalysisDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn
+
+	awsAccountId, analysisId, err := ParseAnalysisID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input := &quicksight.DeleteAnalysisInput{
+		AnalysisId:   aws.String(analysisId),
+		AwsAccountId: aws.String(awsAccountId),
+	}
+
+	if v := d.Get("recovery_window_in_days").(int); v == 0 {
+		input.ForceDeleteWithoutRecovery = aws.Bool(true)
+	} else {
+		input.RecoveryWindowInDays = aws.Int64(int64(v))
+	}
+
+	log.Printf("[DEBUG] Deleting QuickSight Analysis: %s", d.Id())
+	_, err = conn.DeleteAnalysisWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting QuickSight Analysis (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func ParseAnalysisID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected AWS_ACCOUNT_ID/ANALYSIS_ID", id)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,160 @@
+package quicksight_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfquicksight "github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccQuickSightAnalysis_basic(t *testing.T) {
+	var analysis quicksight.Analysis
+	resourceName := "aws_quicksight_analysis.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	sourceTemplateARN, placeholder := testAccSourceTemplateFromEnv(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		ErrorCheck:        acctest.ErrorCheck(t, quicksight.EndpointsID),
+		CheckDestroy:      testAccCheckQuickSightAnalysisDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnalysisConfig(rId, rName, rName, sourceTemplateARN, placeholder),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightAnalysisExists(resourceName, &analysis),
+					resource.TestCheckResourceAttr(resourceName, "analysis_id", rId),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "quicksight", fmt.Sprintf("analysis/%s", rId)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", quicksight.ResourceStatusCreationSuccessful),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"parameters", "recovery_window_in_days", "source_entity"},
+			},
+			{
+				Config: testAccAnalysisConfig(rId, rName, rName+"-updated", sourceTemplateARN, placeholder),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightAnalysisExists(resourceName, &analysis),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"-updated"),
+					resource.TestCheckResourceAttr(resourceName, "status", quicksight.ResourceStatusUpdateSuccessful),
+				),
+			},
+		},
+	})
+}
+
+func TestAccQuickSightAnalysis_disappears(t *testing.T) {
+	var analysis quicksight.Analysis
+	resourceName := "aws_quicksight_analysis.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	sourceTemplateARN, placeholder := testAccSourceTemplateFromEnv(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		ErrorCheck:        acctest.ErrorCheck(t, quicksight.EndpointsID),
+		CheckDestroy:      testAccCheckQuickSightAnalysisDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnalysisConfig(rId, rName, rName, sourceTemplateARN, placeholder),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightAnalysisExists(resourceName, &analysis),
+					acctest.CheckResourceDisappears(acctest.Provider, tfquicksight.ResourceAnalysis(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckQuickSightAnalysisExists(resourceName string, analysis *quicksight.Analysis) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		awsAccountID, analysisID, err := tfquicksight.ParseAnalysisID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn
+
+		output, err := tfquicksight.FindAnalysisByID(context.Background(), conn, awsAccountID, analysisID)
+
+		if err != nil {
+			return err
+		}
+
+		*analysis = *output
+
+		return nil
+	}
+}
+
+func testAccCheckQuickSightAnalysisDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_quicksight_analysis" {
+			continue
+		}
+
+		awsAccountID, analysisID, err := tfquicksight.ParseAnalysisID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = tfquicksight.FindAnalysisByID(context.Background(), conn, awsAccountID, analysisID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("QuickSight Analysis (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAnalysisConfig(rId, rName, analysisName, sourceTemplateARN, placeholder string) string {
+	return acctest.ConfigCompose(
+		testAccDataSetConfig(rId, rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_analysis" "test" {
+  analysis_id             = %[1]q
+  name                    = %[2]q
+  recovery_window_in_days = 0
+
+  source_entity {
+    source_template {
+      arn = %[3]q
+
+      data_set_references {
+        data_set_arn         = aws_quicksight_data_set.test.arn
+        data_set_placeholder = %[4]q
+      }
+    }
+  }
+}
+`, rId, analysisName, sourceTemplateARN, placeholder))
+}
@@ -0,0 +1,462 @@
+package quicksight
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDashboard() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDashboardCreate,
+		ReadWithoutTimeout:   resourceDashboardRead,
+		UpdateWithoutTimeout: resourceDashboardUpdate,
+		DeleteWithoutTimeout: resourceDashboardDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"aws_account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"dashboard_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+
+			"dashboard_publish_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ad_hoc_filtering_option": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"availability_status": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      quicksight.DashboardBehaviorEnabled,
+										ValidateFunc: validation.StringInSlice(quicksight.DashboardBehavior_Values(), false),
+									},
+								},
+							},
+						},
+						"export_to_csv_option": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"availability_status": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      quicksight.DashboardBehaviorEnabled,
+										ValidateFunc: validation.StringInSlice(quicksight.DashboardBehavior_Values(), false),
+									},
+								},
+							},
+						},
+						"sheet_controls_option": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"visibility_state": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      quicksight.DashboardUIStateCollapsed,
+										ValidateFunc: validation.StringInSlice(quicksight.DashboardUIState_Values(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"last_published_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"last_updated_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+
+			"parameters": parametersSchema(),
+
+			"permission": permissionsSchema(),
+
+			"source_entity": sourceTemplateSchema(),
+
+			"source_entity_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tftags.TagsSchema(),
+
+			"tags_all": tftags.TagsSchemaComputed(),
+
+			"theme_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+
+			"version_description": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 512),
+			},
+
+			"version_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDashboardCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	awsAccountId := meta.(*conns.AWSClient).AccountID
+	dashboardId := d.Get("dashboard_id").(string)
+
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountId = v.(string)
+	}
+
+	templateArn, dataSetReferences := expandQuickSightSourceTemplate(d.Get("source_entity").([]interface{}))
+	input := &quicksight.CreateDashboardInput{
+		AwsAccountId: aws.String(awsAccountId),
+		DashboardId:  aws.String(dashboardId),
+		Name:         aws.String(d.Get("name").(string)),
+		SourceEntity: &quicksight.DashboardSourceEntity{
+			SourceTemplate: &quicksight.DashboardSourceTemplate{
+				Arn:               templateArn,
+				DataSetReferences: dataSetReferences,
+			},
+		},
+		VersionDescription: aws.String(d.Get("version_description").(string)),
+	}
+
+	if v, ok := d.GetOk("dashboard_publish_options"); ok && len(v.([]interface{})) > 0 {
+		input.DashboardPublishOptions = expandQuickSightDashboardPublishOptions(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("parameters"); ok && len(v.([]interface{})) > 0 {
+		input.Parameters = expandQuickSightParameters(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("permission"); ok && v.(*schema.Set).Len() > 0 {
+		input.Permissions = expandQuickSightDataSourcePermissions(v.(*schema.Set).List())
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	if v, ok := d.GetOk("theme_arn"); ok {
+		input.ThemeArn = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateDashboardWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating QuickSight Dashboard (%s): %s", dashboardId, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", awsAccountId, dashboardId))
+
+	versionNumber, err := versionNumberFromARN(aws.StringValue(output.VersionArn))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := waitDashboardCreated(ctx, conn, awsAccountId, dashboardId, versionNumber); err != nil {
+		return diag.Errorf("error waiting for QuickSight Dashboard (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceDashboardRead(ctx, d, meta)
+}
+
+func resourceDashboardRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	awsAccountId, dashboardId, err := ParseDashboardID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	dashboard, err := FindDashboardByID(ctx, conn, awsAccountId, dashboardId, 0)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] QuickSight Dashboard (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading QuickSight Dashboard (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", dashboard.Arn)
+	d.Set("aws_account_id", awsAccountId)
+	d.Set("created_time", aws.TimeValue(dashboard.CreatedTime).Format(time.RFC3339))
+	d.Set("dashboard_id", dashboard.DashboardId)
+	d.Set("last_published_time", aws.TimeValue(dashboard.LastPublishedTime).Format(time.RFC3339))
+	d.Set("last_updated_time", aws.TimeValue(dashboard.LastUpdatedTime).Format(time.RFC3339))
+	d.Set("name", dashboard.Name)
+	d.Set("source_entity_arn", dashboard.Version.SourceEntityArn)
+	d.Set("status", dashboard.Version.Status)
+	d.Set("theme_arn", dashboard.Version.ThemeArn)
+	d.Set("version_description", dashboard.Version.Description)
+	d.Set("version_number", dashboard.Version.VersionNumber)
+
+	tags, err := ListTags(conn, d.Get("arn").(string))
+
+	if err != nil {
+		return diag.Errorf("error listing tags for QuickSight Dashboard (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	permsResp, err := conn.DescribeDashboardPermissionsWithContext(ctx, &quicksight.DescribeDashboardPermissionsInput{
+		AwsAccountId: aws.String(awsAccountId),
+		DashboardId:  aws.String(dashboardId),
+	})
+
+	if err != nil {
+		return diag.Errorf("error describing QuickSight Dashboard (%s) Permissions: %s", d.Id(), err)
+	}
+
+	if err := d.Set("permission", flattenQuickSightPermissions(permsResp.Permissions)); err != nil {
+		return diag.Errorf("error setting permission: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDashboardUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn
+
+	awsAccountId, dashboardId, err := ParseDashboardID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChangesExcept("permission", "tags", "tags_all") {
+		templateArn, dataSetReferences := expandQuickSightSourceTemplate(d.Get("source_entity").([]interface{}))
+		input := &quicksight.UpdateDashboardInput{
+			AwsAccountId:            aws.String(awsAccountId),
+			DashboardId:             aws.String(dashboardId),
+			DashboardPublishOptions: expandQuickSightDashboardPublishOptions(d.Get("dashboard_publish_options").([]interface{})),
+			Name:                    aws.String(d.Get("name").(string)),
+			Parameters:              expandQuickSightParameters(d.Get("parameters").([]interface{})),
+			SourceEntity: &quicksight.DashboardSourceEntity{
+				SourceTemplate: &quicksight.DashboardSourceTemplate{
+					Arn:               templateArn,
+					DataSetReferences: dataSetReferences,
+				},
+			},
+			VersionDescription: aws.String(d.Get("version_description").(string)),
+		}
+
+		if v, ok := d.GetOk("theme_arn"); ok {
+			input.ThemeArn = aws.String(v.(string))
+		}
+
+		output, err := conn.UpdateDashboardWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating QuickSight Dashboard (%s): %s", d.Id(), err)
+		}
+
+		// Each update creates a new dashboard version which must be published explicitly.
+		versionNumber, err := versionNumberFromARN(aws.StringValue(output.VersionArn))
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if _, err := waitDashboardUpdated(ctx, conn, awsAccountId, dashboardId, versionNumber); err != nil {
+			return diag.Errorf("error waiting for QuickSight Dashboard (%s) update: %s", d.Id(), err)
+		}
+
+		_, err = conn.UpdateDashboardPublishedVersionWithContext(ctx, &quicksight.UpdateDashboardPublishedVersionInput{
+			AwsAccountId:  aws.String(awsAccountId),
+			DashboardId:   aws.String(dashboardId),
+			VersionNumber: aws.Int64(versionNumber),
+		})
+
+		if err != nil {
+			return diag.Errorf("error publishing QuickSight Dashboard (%s) version (%d): %s", d.Id(), versionNumber, err)
+		}
+	}
+
+	if d.HasChange("permission") {
+		o, n := d.GetChange("permission")
+		toGrant, toRevoke := DiffPermissions(o.(*schema.Set).List(), n.(*schema.Set).List())
+
+		input := &quicksight.UpdateDashboardPermissionsInput{
+			AwsAccountId: aws.String(awsAccountId),
+			DashboardId:  aws.String(dashboardId),
+		}
+
+		if len(toGrant) > 0 {
+			input.GrantPermissions = toGrant
+		}
+
+		if len(toRevoke) > 0 {
+			input.RevokePermissions = toRevoke
+		}
+
+		_, err = conn.UpdateDashboardPermissionsWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating QuickSight Dashboard (%s) permissions: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating QuickSight Dashboard (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceDashboardRead(ctx, d, meta)
+}
+
+func resourceDashboardDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn
+
+	awsAccountId, dashboardId, err := ParseDashboardID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting QuickSight Dashboard: %s", d.Id())
+	_, err = conn.DeleteDashboardWithContext(ctx, &quicksight.DeleteDashboardInput{
+		AwsAccountId: aws.String(awsAccountId),
+		DashboardId:  aws.String(dashboardId),
+	})
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting QuickSight Dashboard (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandQuickSightDashboardPublishOptions(tfList []interface{}) *quicksight.DashboardPublishOptions {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &quicksight.DashboardPublishOptions{}
+
+	if v, ok := tfMap["ad_hoc_filtering_option"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.AdHocFilteringOption = &quicksight.AdHocFilteringOption{
+			AvailabilityStatus: aws.String(v[0].(map[string]interface{})["availability_status"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["export_to_csv_option"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ExportToCSVOption = &quicksight.ExportToCSVOption{
+			AvailabilityStatus: aws.String(v[0].(map[string]interface{})["availability_status"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["sheet_controls_option"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SheetControlsOption = &quicksight.SheetControlsOption{
+			VisibilityState: aws.String(v[0].(map[string]interface{})["visibility_state"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func ParseDashboardID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected AWS_ACCOUNT_ID/DASHBOARD_ID", id)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,169 @@
+package quicksight_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfquicksight "github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccQuickSightDashboard_basic(t *testing.T) {
+	var dashboard quicksight.Dashboard
+	resourceName := "aws_quicksight_dashboard.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	sourceTemplateARN, placeholder := testAccSourceTemplateFromEnv(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		ErrorCheck:        acctest.ErrorCheck(t, quicksight.EndpointsID),
+		CheckDestroy:      testAccCheckQuickSightDashboardDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardConfig(rId, rName, "1", sourceTemplateARN, placeholder),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightDashboardExists(resourceName, &dashboard),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "quicksight", fmt.Sprintf("dashboard/%s", rId)),
+					resource.TestCheckResourceAttr(resourceName, "dashboard_id", rId),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "source_entity_arn", sourceTemplateARN),
+					resource.TestCheckResourceAttr(resourceName, "status", quicksight.ResourceStatusCreationSuccessful),
+					resource.TestCheckResourceAttr(resourceName, "version_description", "1"),
+					resource.TestCheckResourceAttr(resourceName, "version_number", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"dashboard_publish_options", "parameters", "source_entity"},
+			},
+			{
+				Config: testAccDashboardConfig(rId, rName, "2", sourceTemplateARN, placeholder),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightDashboardExists(resourceName, &dashboard),
+					resource.TestCheckResourceAttr(resourceName, "version_description", "2"),
+					resource.TestCheckResourceAttr(resourceName, "version_number", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccQuickSightDashboard_disappears(t *testing.T) {
+	var dashboard quicksight.Dashboard
+	resourceName := "aws_quicksight_dashboard.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	sourceTemplateARN, placeholder := testAccSourceTemplateFromEnv(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		ErrorCheck:        acctest.ErrorCheck(t, quicksight.EndpointsID),
+		CheckDestroy:      testAccCheckQuickSightDashboardDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardConfig(rId, rName, "1", sourceTemplateARN, placeholder),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightDashboardExists(resourceName, &dashboard),
+					acctest.CheckResourceDisappears(acctest.Provider, tfquicksight.ResourceDashboard(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckQuickSightDashboardExists(resourceName string, dashboard *quicksight.Dashboard) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		awsAccountID, dashboardID, err := tfquicksight.ParseDashboardID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn
+
+		output, err := tfquicksight.FindDashboardByID(context.Background(), conn, awsAccountID, dashboardID, 0)
+
+		if err != nil {
+			return err
+		}
+
+		*dashboard = *output
+
+		return nil
+	}
+}
+
+func testAccCheckQuickSightDashboardDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_quicksight_dashboard" {
+			continue
+		}
+
+		awsAccountID, dashboardID, err := tfquicksight.ParseDashboardID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = tfquicksight.FindDashboardByID(context.Background(), conn, awsAccountID, dashboardID, 0)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("QuickSight Dashboard (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccDashboardConfig(rId, rName, versionDescription, sourceTemplateARN, placeholder string) string {
+	return acctest.ConfigCompose(
+		testAccDataSetConfig(rId, rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_dashboard" "test" {
+  dashboard_id        = %[1]q
+  name                = %[2]q
+  version_description = %[3]q
+
+  source_entity {
+    source_template {
+      arn = %[4]q
+
+      data_set_references {
+        data_set_arn         = aws_quicksight_data_set.test.arn
+        data_set_placeholder = %[5]q
+      }
+    }
+  }
+
+  dashboard_publish_options {
+    ad_hoc_filtering_option {
+      availability_status = "DISABLED"
+    }
+  }
+}
+`, rId, rName, versionDescription, sourceTemplateARN, placeholder))
+}
@@ -0,0 +1,1766 @@
+package quicksight
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDataSet() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDataSetCreate,
+		ReadWithoutTimeout:   resourceDataSetRead,
+		UpdateWithoutTimeout: resourceDataSetUpdate,
+		DeleteWithoutTimeout: resourceDataSetDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"aws_account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+
+			"column_groups": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MinItems: 1,
+				MaxItems: 8,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"geo_spatial_column_group": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"columns": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										MaxItems: 16,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(1, 128),
+										},
+									},
+									"country_code": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(quicksight.GeoSpatialCountryCode_Values(), false),
+									},
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 64),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"column_level_permission_rules": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"column_names": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"principals": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MinItems: 1,
+							MaxItems: 100,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
+			"data_set_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"data_set_usage_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"disable_use_as_direct_query_source": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"disable_use_as_imported_source": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"field_folders": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MinItems: 1,
+				MaxItems: 1000,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"columns": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 5000,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 500),
+						},
+						"field_folders_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"import_mode": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(quicksight.DataSetImportMode_Values(), false),
+			},
+
+			"logical_table_map": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				MaxItems: 64,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alias": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 64),
+						},
+						"data_transforms": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MinItems: 1,
+							MaxItems: 2048,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cast_column_type_operation": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"column_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 128),
+												},
+												"format": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(0, 32),
+												},
+												"new_column_type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(quicksight.ColumnDataType_Values(), false),
+												},
+											},
+										},
+									},
+									"create_columns_operation": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"columns": {
+													Type:     schema.TypeList,
+													Required: true,
+													MinItems: 1,
+													MaxItems: 128,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"column_id": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 64),
+															},
+															"column_name": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 128),
+															},
+															"expression": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 4096),
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"filter_operation": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"condition_expression": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 4096),
+												},
+											},
+										},
+									},
+									"project_operation": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"projected_columns": {
+													Type:     schema.TypeList,
+													Required: true,
+													MinItems: 1,
+													MaxItems: 2000,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+									"rename_column_operation": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"column_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 128),
+												},
+												"new_column_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 128),
+												},
+											},
+										},
+									},
+									"tag_column_operation": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"column_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 128),
+												},
+												"tags": {
+													Type:     schema.TypeList,
+													Required: true,
+													MinItems: 1,
+													MaxItems: 16,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"column_description": {
+																Type:     schema.TypeList,
+																Optional: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"text": {
+																			Type:         schema.TypeString,
+																			Optional:     true,
+																			ValidateFunc: validation.StringLenBetween(0, 500),
+																		},
+																	},
+																},
+															},
+															"column_geographic_role": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																ValidateFunc: validation.StringInSlice(quicksight.GeoSpatialDataRole_Values(), false),
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"untag_column_operation": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"column_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 128),
+												},
+												"tag_names": {
+													Type:     schema.TypeList,
+													Required: true,
+													Elem: &schema.Schema{
+														Type:         schema.TypeString,
+														ValidateFunc: validation.StringInSlice(quicksight.ColumnTagName_Values(), false),
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"logical_table_map_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 64),
+						},
+						"source": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"data_set_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"join_instruction": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"left_join_key_properties": dataSetJoinKeyPropertiesSchema(),
+												"left_operand": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 64),
+												},
+												"on_clause": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 512),
+												},
+												"right_join_key_properties": dataSetJoinKeyPropertiesSchema(),
+												"right_operand": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 64),
+												},
+												"type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(quicksight.JoinType_Values(), false),
+												},
+											},
+										},
+									},
+									"physical_table_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 64),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+
+			"output_columns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"permission": permissionsSchema(),
+
+			"physical_table_map": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MaxItems: 32,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"custom_sql": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"columns": dataSetInputColumnsSchema(false),
+									"data_source_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 64),
+									},
+									"sql_query": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 65536),
+									},
+								},
+							},
+						},
+						"physical_table_map_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 64),
+						},
+						"relational_table": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"catalog": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(0, 256),
+									},
+									"data_source_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"input_columns": dataSetInputColumnsSchema(true),
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 64),
+									},
+									"schema": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"s3_source": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"data_source_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"input_columns": dataSetInputColumnsSchema(true),
+									"upload_settings": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"contains_header": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Computed: true,
+												},
+												"delimiter": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.StringLenBetween(1, 1),
+												},
+												"format": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.StringInSlice(quicksight.FileFormat_Values(), false),
+												},
+												"start_from_row": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+												"text_qualifier": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.StringInSlice(quicksight.TextQualifier_Values(), false),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"row_level_permission_data_set": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"format_version": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(quicksight.RowLevelPermissionFormatVersion_Values(), false),
+						},
+						"namespace": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 64),
+						},
+						"permission_policy": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(quicksight.RowLevelPermissionPolicy_Values(), false),
+						},
+						"status": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(quicksight.Status_Values(), false),
+						},
+					},
+				},
+			},
+
+			"row_level_permission_tag_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"status": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(quicksight.Status_Values(), false),
+						},
+						"tag_rules": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 50,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"column_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"match_all_value": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Sensitive:    true,
+										ValidateFunc: validation.StringLenBetween(1, 256),
+									},
+									"tag_key": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 128),
+									},
+									"tag_multi_value_delimiter": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(0, 10),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"tags": tftags.TagsSchema(),
+
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func dataSetInputColumnsSchema(required bool) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: required,
+		Optional: !required,
+		MinItems: 1,
+		MaxItems: 2048,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 128),
+				},
+				"type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(quicksight.InputColumnDataType_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+func dataSetJoinKeyPropertiesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"unique_key": {
+					Type:     schema.TypeBool,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceDataSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	awsAccountId := meta.(*conns.AWSClient).AccountID
+	dataSetId := d.Get("data_set_id").(string)
+
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountId = v.(string)
+	}
+
+	input := &quicksight.CreateDataSetInput{
+		AwsAccountId:     aws.String(awsAccountId),
+		DataSetId:        aws.String(dataSetId),
+		ImportMode:       aws.String(d.Get("import_mode").(string)),
+		Name:             aws.String(d.Get("name").(string)),
+		PhysicalTableMap: expandQuickSightDataSetPhysicalTableMap(d.Get("physical_table_map").(*schema.Set).List()),
+	}
+
+	if v, ok := d.GetOk("column_groups"); ok && len(v.([]interface{})) > 0 {
+		input.ColumnGroups = expandQuickSightDataSetColumnGroups(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("column_level_permission_rules"); ok && len(v.([]interface{})) > 0 {
+		input.ColumnLevelPermissionRules = expandQuickSightDataSetColumnLevelPermissionRules(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("data_set_usage_configuration"); ok && len(v.([]interface{})) > 0 {
+		input.DataSetUsageConfiguration = expandQuickSightDataSetUsageConfiguration(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("field_folders"); ok && v.(*schema.Set).Len() > 0 {
+		input.FieldFolders = expandQuickSightDataSetFieldFolders(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("logical_table_map"); ok && v.(*schema.Set).Len() > 0 {
+		input.LogicalTableMap = expandQuickSightDataSetLogicalTableMap(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("permission"); ok && v.(*schema.Set).Len() > 0 {
+		input.Permissions = expandQuickSightDataSourcePermissions(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("row_level_permission_data_set"); ok && len(v.([]interface{})) > 0 {
+		input.RowLevelPermissionDataSet = expandQuickSightDataSetRowLevelPermissionDataSet(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("row_level_permission_tag_configuration"); ok && len(v.([]interface{})) > 0 {
+		input.RowLevelPermissionTagConfiguration = expandQuickSightDataSetRowLevelPermissionTagConfiguration(v.([]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	_, err := conn.CreateDataSetWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating QuickSight Data Set (%s): %s", dataSetId, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", awsAccountId, dataSetId))
+
+	return resourceDataSetRead(ctx, d, meta)
+}
+
+func resourceDataSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	awsAccountId, dataSetId, err := ParseDataSetID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	dataSet, err := FindDataSetByID(ctx, conn, awsAccountId, dataSetId)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] QuickSight Data Set (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading QuickSight Data Set (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", dataSet.Arn)
+	d.Set("aws_account_id", awsAccountId)
+	d.Set("data_set_id", dataSet.DataSetId)
+	d.Set("import_mode", dataSet.ImportMode)
+	d.Set("name", dataSet.Name)
+
+	if err := d.Set("column_groups", flattenQuickSightDataSetColumnGroups(dataSet.ColumnGroups)); err != nil {
+		return diag.Errorf("error setting column_groups: %s", err)
+	}
+
+	if err := d.Set("column_level_permission_rules", flattenQuickSightDataSetColumnLevelPermissionRules(dataSet.ColumnLevelPermissionRules)); err != nil {
+		return diag.Errorf("error setting column_level_permission_rules: %s", err)
+	}
+
+	if err := d.Set("data_set_usage_configuration", flattenQuickSightDataSetUsageConfiguration(dataSet.DataSetUsageConfiguration)); err != nil {
+		return diag.Errorf("error setting data_set_usage_configuration: %s", err)
+	}
+
+	if err := d.Set("field_folders", flattenQuickSightDataSetFieldFolders(dataSet.FieldFolders)); err != nil {
+		return diag.Errorf("error setting field_folders: %s", err)
+	}
+
+	if err := d.Set("logical_table_map", flattenQuickSightDataSetLogicalTableMap(dataSet.LogicalTableMap)); err != nil {
+		return diag.Errorf("error setting logical_table_map: %s", err)
+	}
+
+	if err := d.Set("output_columns", flattenQuickSightDataSetOutputColumns(dataSet.OutputColumns)); err != nil {
+		return diag.Errorf("error setting output_columns: %s", err)
+	}
+
+	if err := d.Set("physical_table_map", flattenQuickSightDataSetPhysicalTableMap(dataSet.PhysicalTableMap)); err != nil {
+		return diag.Errorf("error setting physical_table_map: %s", err)
+	}
+
+	if err := d.Set("row_level_permission_data_set", flattenQuickSightDataSetRowLevelPermissionDataSet(dataSet.RowLevelPermissionDataSet)); err != nil {
+		return diag.Errorf("error setting row_level_permission_data_set: %s", err)
+	}
+
+	if err := d.Set("row_level_permission_tag_configuration", flattenQuickSightDataSetRowLevelPermissionTagConfiguration(dataSet.RowLevelPermissionTagConfiguration)); err != nil {
+		return diag.Errorf("error setting row_level_permission_tag_configuration: %s", err)
+	}
+
+	tags, err := ListTags(conn, d.Get("arn").(string))
+
+	if err != nil {
+		return diag.Errorf("error listing tags for QuickSight Data Set (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	permsResp, err := conn.DescribeDataSetPermissionsWithContext(ctx, &quicksight.DescribeDataSetPermissionsInput{
+		AwsAccountId: aws.String(awsAccountId),
+		DataSetId:    aws.String(dataSetId),
+	})
+
+	if err != nil {
+		return diag.Errorf("error describing QuickSight Data Set (%s) Permissions: %s", d.Id(), err)
+	}
+
+	if err := d.Set("permission", flattenQuickSightPermissions(permsResp.Permissions)); err != nil {
+		return diag.Errorf("error setting permission: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDataSetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn
+
+	awsAccountId, dataSetId, err := ParseDataSetID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChangesExcept("permission", "tags", "tags_all") {
+		input := &quicksight.UpdateDataSetInput{
+			AwsAccountId:                       aws.String(awsAccountId),
+			ColumnGroups:                       expandQuickSightDataSetColumnGroups(d.Get("column_groups").([]interface{})),
+			ColumnLevelPermissionRules:         expandQuickSightDataSetColumnLevelPermissionRules(d.Get("column_level_permission_rules").([]interface{})),
+			DataSetId:                          aws.String(dataSetId),
+			DataSetUsageConfiguration:          expandQuickSightDataSetUsageConfiguration(d.Get("data_set_usage_configuration").([]interface{})),
+			FieldFolders:                       expandQuickSightDataSetFieldFolders(d.Get("field_folders").(*schema.Set).List()),
+			ImportMode:                         aws.String(d.Get("import_mode").(string)),
+			LogicalTableMap:                    expandQuickSightDataSetLogicalTableMap(d.Get("logical_table_map").(*schema.Set).List()),
+			Name:                               aws.String(d.Get("name").(string)),
+			PhysicalTableMap:                   expandQuickSightDataSetPhysicalTableMap(d.Get("physical_table_map").(*schema.Set).List()),
+			RowLevelPermissionDataSet:          expandQuickSightDataSetRowLevelPermissionDataSet(d.Get("row_level_permission_data_set").([]interface{})),
+			RowLevelPermissionTagConfiguration: expandQuickSightDataSetRowLevelPermissionTagConfiguration(d.Get("row_level_permission_tag_configuration").([]interface{})),
+		}
+
+		_, err := conn.UpdateDataSetWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating QuickSight Data Set (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("permission") {
+		o, n := d.GetChange("permission")
+		toGrant, toRevoke := DiffPermissions(o.(*schema.Set).List(), n.(*schema.Set).List())
+
+		input := &quicksight.UpdateDataSetPermissionsInput{
+			AwsAccountId: aws.String(awsAccountId),
+			DataSetId:    aws.String(dataSetId),
+		}
+
+		if len(toGrant) > 0 {
+			input.GrantPermissions = toGrant
+		}
+
+		if len(toRevoke) > 0 {
+			input.RevokePermissions = toRevoke
+		}
+
+		_, err = conn.UpdateDataSetPermissionsWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating QuickSight Data Set (%s) permissions: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating QuickSight Data Set (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceDataSetRead(ctx, d, meta)
+}
+
+func resourceDataSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn
+
+	awsAccountId, dataSetId, err := ParseDataSetID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting QuickSight Data Set: %s", d.Id())
+	_, err = conn.DeleteDataSetWithContext(ctx, &quicksight.DeleteDataSetInput{
+		AwsAccountId: aws.String(awsAccountId),
+		DataSetId:    aws.String(dataSetId),
+	})
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting QuickSight Data Set (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func ParseDataSetID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected AWS_ACCOUNT_ID/DATA_SET_ID", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func expandQuickSightDataSetColumnGroups(tfList []interface{}) []*quicksight.ColumnGroup {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*quicksight.ColumnGroup
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &quicksight.ColumnGroup{}
+
+		if v, ok := tfMap["geo_spatial_column_group"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.GeoSpatialColumnGroup = &quicksight.GeoSpatialColumnGroup{
+				Columns:     flex.ExpandStringList(tfMap["columns"].([]interface{})),
+				CountryCode: aws.String(tfMap["country_code"].(string)),
+				Name:        aws.String(tfMap["name"].(string)),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandQuickSightDataSetColumnLevelPermissionRules(tfList []interface{}) []*quicksight.ColumnLevelPermissionRule {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*quicksight.ColumnLevelPermissionRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &quicksight.ColumnLevelPermissionRule{}
+
+		if v, ok := tfMap["column_names"].([]interface{}); ok && len(v) > 0 {
+			apiObject.ColumnNames = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["principals"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Principals = flex.ExpandStringList(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandQuickSightDataSetUsageConfiguration(tfList []interface{}) *quicksight.DataSetUsageConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &quicksight.DataSetUsageConfiguration{
+		DisableUseAsDirectQuerySource: aws.Bool(tfMap["disable_use_as_direct_query_source"].(bool)),
+		DisableUseAsImportedSource:    aws.Bool(tfMap["disable_use_as_imported_source"].(bool)),
+	}
+}
+
+func expandQuickSightDataSetFieldFolders(tfList []interface{}) map[string]*quicksight.FieldFolder {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObjects := make(map[string]*quicksight.FieldFolder)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &quicksight.FieldFolder{}
+
+		if v, ok := tfMap["columns"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Columns = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["description"].(string); ok && v != "" {
+			apiObject.Description = aws.String(v)
+		}
+
+		apiObjects[tfMap["field_folders_id"].(string)] = apiObject
+	}
+
+	return apiObjects
+}
+
+func expandQuickSightDataSetLogicalTableMap(tfList []interface{}) map[string]*quicksight.LogicalTable {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObjects := make(map[string]*quicksight.LogicalTable)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &quicksight.LogicalTable{
+			Alias: aws.String(tfMap["alias"].(string)),
+		}
+
+		if v, ok := tfMap["data_transforms"].([]interface{}); ok && len(v) > 0 {
+			apiObject.DataTransforms = expandQuickSightDataSetDataTransforms(v)
+		}
+
+		if v, ok := tfMap["source"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Source = expandQuickSightDataSetLogicalTableSource(v[0].(map[string]interface{}))
+		}
+
+		apiObjects[tfMap["logical_table_map_id"].(string)] = apiObject
+	}
+
+	return apiObjects
+}
+
+func expandQuickSightDataSetLogicalTableSource(tfMap map[string]interface{}) *quicksight.LogicalTableSource {
+	apiObject := &quicksight.LogicalTableSource{}
+
+	if v, ok := tfMap["data_set_arn"].(string); ok && v != "" {
+		apiObject.DataSetArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["join_instruction"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.JoinInstruction = &quicksight.JoinInstruction{
+			LeftJoinKeyProperties:  expandQuickSightDataSetJoinKeyProperties(tfMap["left_join_key_properties"].([]interface{})),
+			LeftOperand:            aws.String(tfMap["left_operand"].(string)),
+			OnClause:               aws.String(tfMap["on_clause"].(string)),
+			RightJoinKeyProperties: expandQuickSightDataSetJoinKeyProperties(tfMap["right_join_key_properties"].([]interface{})),
+			RightOperand:           aws.String(tfMap["right_operand"].(string)),
+			Type:                   aws.String(tfMap["type"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["physical_table_id"].(string); ok && v != "" {
+		apiObject.PhysicalTableId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandQuickSightDataSetJoinKeyProperties(tfList []interface{}) *quicksight.JoinKeyProperties {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &quicksight.JoinKeyProperties{
+		UniqueKey: aws.Bool(tfMap["unique_key"].(bool)),
+	}
+}
+
+func expandQuickSightDataSetDataTransforms(tfList []interface{}) []*quicksight.TransformOperation {
+	var apiObjects []*quicksight.TransformOperation
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &quicksight.TransformOperation{}
+
+		if v, ok := tfMap["cast_column_type_operation"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.CastColumnTypeOperation = &quicksight.CastColumnTypeOperation{
+				ColumnName:    aws.String(tfMap["column_name"].(string)),
+				NewColumnType: aws.String(tfMap["new_column_type"].(string)),
+			}
+
+			if v, ok := tfMap["format"].(string); ok && v != "" {
+				apiObject.CastColumnTypeOperation.Format = aws.String(v)
+			}
+		}
+
+		if v, ok := tfMap["create_columns_operation"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			operation := &quicksight.CreateColumnsOperation{}
+
+			for _, tfMapRaw := range tfMap["columns"].([]interface{}) {
+				tfMap := tfMapRaw.(map[string]interface{})
+
+				operation.Columns = append(operation.Columns, &quicksight.CalculatedColumn{
+					ColumnId:   aws.String(tfMap["column_id"].(string)),
+					ColumnName: aws.String(tfMap["column_name"].(string)),
+					Expression: aws.String(tfMap["expression"].(string)),
+				})
+			}
+
+			apiObject.CreateColumnsOperation = operation
+		}
+
+		if v, ok := tfMap["filter_operation"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.FilterOperation = &quicksight.FilterOperation{
+				ConditionExpression: aws.String(tfMap["condition_expression"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["project_operation"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.ProjectOperation = &quicksight.ProjectOperation{
+				ProjectedColumns: flex.ExpandStringList(tfMap["projected_columns"].([]interface{})),
+			}
+		}
+
+		if v, ok := tfMap["rename_column_operation"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.RenameColumnOperation = &quicksight.RenameColumnOperation{
+				ColumnName:    aws.String(tfMap["column_name"].(string)),
+				NewColumnName: aws.String(tfMap["new_column_name"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["tag_column_operation"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			operation := &quicksight.TagColumnOperation{
+				ColumnName: aws.String(tfMap["column_name"].(string)),
+			}
+
+			for _, tfMapRaw := range tfMap["tags"].([]interface{}) {
+				tfMap := tfMapRaw.(map[string]interface{})
+				tag := &quicksight.ColumnTag{}
+
+				if v, ok := tfMap["column_description"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+					tag.ColumnDescription = &quicksight.ColumnDescription{
+						Text: aws.String(v[0].(map[string]interface{})["text"].(string)),
+					}
+				}
+
+				if v, ok := tfMap["column_geographic_role"].(string); ok && v != "" {
+					tag.ColumnGeographicRole = aws.String(v)
+				}
+
+				operation.Tags = append(operation.Tags, tag)
+			}
+
+			apiObject.TagColumnOperation = operation
+		}
+
+		if v, ok := tfMap["untag_column_operation"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.UntagColumnOperation = &quicksight.UntagColumnOperation{
+				ColumnName: aws.String(tfMap["column_name"].(string)),
+				TagNames:   flex.ExpandStringList(tfMap["tag_names"].([]interface{})),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandQuickSightDataSetPhysicalTableMap(tfList []interface{}) map[string]*quicksight.PhysicalTable {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObjects := make(map[string]*quicksight.PhysicalTable)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &quicksight.PhysicalTable{}
+
+		if v, ok := tfMap["custom_sql"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.CustomSql = &quicksight.CustomSql{
+				Columns:       expandQuickSightDataSetInputColumns(tfMap["columns"].([]interface{})),
+				DataSourceArn: aws.String(tfMap["data_source_arn"].(string)),
+				Name:          aws.String(tfMap["name"].(string)),
+				SqlQuery:      aws.String(tfMap["sql_query"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["relational_table"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.RelationalTable = &quicksight.RelationalTable{
+				DataSourceArn: aws.String(tfMap["data_source_arn"].(string)),
+				InputColumns:  expandQuickSightDataSetInputColumns(tfMap["input_columns"].([]interface{})),
+				Name:          aws.String(tfMap["name"].(string)),
+			}
+
+			if v, ok := tfMap["catalog"].(string); ok && v != "" {
+				apiObject.RelationalTable.Catalog = aws.String(v)
+			}
+
+			if v, ok := tfMap["schema"].(string); ok && v != "" {
+				apiObject.RelationalTable.Schema = aws.String(v)
+			}
+		}
+
+		if v, ok := tfMap["s3_source"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.S3Source = &quicksight.S3Source{
+				DataSourceArn: aws.String(tfMap["data_source_arn"].(string)),
+				InputColumns:  expandQuickSightDataSetInputColumns(tfMap["input_columns"].([]interface{})),
+			}
+
+			if v, ok := tfMap["upload_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				apiObject.S3Source.UploadSettings = expandQuickSightDataSetUploadSettings(v[0].(map[string]interface{}))
+			}
+		}
+
+		apiObjects[tfMap["physical_table_map_id"].(string)] = apiObject
+	}
+
+	return apiObjects
+}
+
+func expandQuickSightDataSetInputColumns(tfList []interface{}) []*quicksight.InputColumn {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*quicksight.InputColumn
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &quicksight.InputColumn{
+			Name: aws.String(tfMap["name"].(string)),
+			Type: aws.String(tfMap["type"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandQuickSightDataSetUploadSettings(tfMap map[string]interface{}) *quicksight.UploadSettings {
+	apiObject := &quicksight.UploadSettings{}
+
+	if v, ok := tfMap["contains_header"].(bool); ok {
+		apiObject.ContainsHeader = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["delimiter"].(string); ok && v != "" {
+		apiObject.Delimiter = aws.String(v)
+	}
+
+	if v, ok := tfMap["format"].(string); ok && v != "" {
+		apiObject.Format = aws.String(v)
+	}
+
+	if v, ok := tfMap["start_from_row"].(int); ok && v != 0 {
+		apiObject.StartFromRow = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["text_qualifier"].(string); ok && v != "" {
+		apiObject.TextQualifier = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandQuickSightDataSetRowLevelPermissionDataSet(tfList []interface{}) *quicksight.RowLevelPermissionDataSet {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &quicksight.RowLevelPermissionDataSet{
+		Arn:              aws.String(tfMap["arn"].(string)),
+		PermissionPolicy: aws.String(tfMap["permission_policy"].(string)),
+	}
+
+	if v, ok := tfMap["format_version"].(string); ok && v != "" {
+		apiObject.FormatVersion = aws.String(v)
+	}
+
+	if v, ok := tfMap["namespace"].(string); ok && v != "" {
+		apiObject.Namespace = aws.String(v)
+	}
+
+	if v, ok := tfMap["status"].(string); ok && v != "" {
+		apiObject.Status = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandQuickSightDataSetRowLevelPermissionTagConfiguration(tfList []interface{}) *quicksight.RowLevelPermissionTagConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &quicksight.RowLevelPermissionTagConfiguration{}
+
+	if v, ok := tfMap["status"].(string); ok && v != "" {
+		apiObject.Status = aws.String(v)
+	}
+
+	for _, tfMapRaw := range tfMap["tag_rules"].([]interface{}) {
+		tfMap := tfMapRaw.(map[string]interface{})
+		rule := &quicksight.RowLevelPermissionTagRule{
+			ColumnName: aws.String(tfMap["column_name"].(string)),
+			TagKey:     aws.String(tfMap["tag_key"].(string)),
+		}
+
+		if v, ok := tfMap["match_all_value"].(string); ok && v != "" {
+			rule.MatchAllValue = aws.String(v)
+		}
+
+		if v, ok := tfMap["tag_multi_value_delimiter"].(string); ok && v != "" {
+			rule.TagMultiValueDelimiter = aws.String(v)
+		}
+
+		apiObject.TagRules = append(apiObject.TagRules, rule)
+	}
+
+	return apiObject
+}
+
+func flattenQuickSightDataSetColumnGroups(apiObjects []*quicksight.ColumnGroup) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.GeoSpatialColumnGroup; v != nil {
+			tfMap["geo_spatial_column_group"] = []interface{}{map[string]interface{}{
+				"columns":      aws.StringValueSlice(v.Columns),
+				"country_code": aws.StringValue(v.CountryCode),
+				"name":         aws.StringValue(v.Name),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenQuickSightDataSetColumnLevelPermissionRules(apiObjects []*quicksight.ColumnLevelPermissionRule) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"column_names": aws.StringValueSlice(apiObject.ColumnNames),
+			"principals":   aws.StringValueSlice(apiObject.Principals),
+		})
+	}
+
+	return tfList
+}
+
+func flattenQuickSightDataSetUsageConfiguration(apiObject *quicksight.DataSetUsageConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"disable_use_as_direct_query_source": aws.BoolValue(apiObject.DisableUseAsDirectQuerySource),
+		"disable_use_as_imported_source":     aws.BoolValue(apiObject.DisableUseAsImportedSource),
+	}}
+}
+
+func flattenQuickSightDataSetFieldFolders(apiObjects map[string]*quicksight.FieldFolder) []interface{} {
+	var tfList []interface{}
+
+	for k, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"columns":          aws.StringValueSlice(apiObject.Columns),
+			"description":      aws.StringValue(apiObject.Description),
+			"field_folders_id": k,
+		})
+	}
+
+	return tfList
+}
+
+func flattenQuickSightDataSetLogicalTableMap(apiObjects map[string]*quicksight.LogicalTable) []interface{} {
+	var tfList []interface{}
+
+	for k, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"alias":                aws.StringValue(apiObject.Alias),
+			"data_transforms":      flattenQuickSightDataSetDataTransforms(apiObject.DataTransforms),
+			"logical_table_map_id": k,
+		}
+
+		if v := apiObject.Source; v != nil {
+			source := map[string]interface{}{
+				"data_set_arn":      aws.StringValue(v.DataSetArn),
+				"physical_table_id": aws.StringValue(v.PhysicalTableId),
+			}
+
+			if v := v.JoinInstruction; v != nil {
+				source["join_instruction"] = []interface{}{map[string]interface{}{
+					"left_join_key_properties":  flattenQuickSightDataSetJoinKeyProperties(v.LeftJoinKeyProperties),
+					"left_operand":              aws.StringValue(v.LeftOperand),
+					"on_clause":                 aws.StringValue(v.OnClause),
+					"right_join_key_properties": flattenQuickSightDataSetJoinKeyProperties(v.RightJoinKeyProperties),
+					"right_operand":             aws.StringValue(v.RightOperand),
+					"type":                      aws.StringValue(v.Type),
+				}}
+			}
+
+			tfMap["source"] = []interface{}{source}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenQuickSightDataSetJoinKeyProperties(apiObject *quicksight.JoinKeyProperties) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"unique_key": aws.BoolValue(apiObject.UniqueKey),
+	}}
+}
+
+func flattenQuickSightDataSetDataTransforms(apiObjects []*quicksight.TransformOperation) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.CastColumnTypeOperation; v != nil {
+			tfMap["cast_column_type_operation"] = []interface{}{map[string]interface{}{
+				"column_name":     aws.StringValue(v.ColumnName),
+				"format":          aws.StringValue(v.Format),
+				"new_column_type": aws.StringValue(v.NewColumnType),
+			}}
+		}
+
+		if v := apiObject.CreateColumnsOperation; v != nil {
+			var columns []interface{}
+
+			for _, column := range v.Columns {
+				columns = append(columns, map[string]interface{}{
+					"column_id":   aws.StringValue(column.ColumnId),
+					"column_name": aws.StringValue(column.ColumnName),
+					"expression":  aws.StringValue(column.Expression),
+				})
+			}
+
+			tfMap["create_columns_operation"] = []interface{}{map[string]interface{}{
+				"columns": columns,
+			}}
+		}
+
+		if v := apiObject.FilterOperation; v != nil {
+			tfMap["filter_operation"] = []interface{}{map[string]interface{}{
+				"condition_expression": aws.StringValue(v.ConditionExpression),
+			}}
+		}
+
+		if v := apiObject.ProjectOperation; v != nil {
+			tfMap["project_operation"] = []interface{}{map[string]interface{}{
+				"projected_columns": aws.StringValueSlice(v.ProjectedColumns),
+			}}
+		}
+
+		if v := apiObject.RenameColumnOperation; v != nil {
+			tfMap["rename_column_operation"] = []interface{}{map[string]interface{}{
+				"column_name":     aws.StringValue(v.ColumnName),
+				"new_column_name": aws.StringValue(v.NewColumnName),
+			}}
+		}
+
+		if v := apiObject.TagColumnOperation; v != nil {
+			var tags []interface{}
+
+			for _, tag := range v.Tags {
+				tagMap := map[string]interface{}{
+					"column_geographic_role": aws.StringValue(tag.ColumnGeographicRole),
+				}
+
+				if tag.ColumnDescription != nil {
+					tagMap["column_description"] = []interface{}{map[string]interface{}{
+						"text": aws.StringValue(tag.ColumnDescription.Text),
+					}}
+				}
+
+				tags = append(tags, tagMap)
+			}
+
+			tfMap["tag_column_operation"] = []interface{}{map[string]interface{}{
+				"column_name": aws.StringValue(v.ColumnName),
+				"tags":        tags,
+			}}
+		}
+
+		if v := apiObject.UntagColumnOperation; v != nil {
+			tfMap["untag_column_operation"] = []interface{}{map[string]interface{}{
+				"column_name": aws.StringValue(v.ColumnName),
+				"tag_names":   aws.StringValueSlice(v.TagNames),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenQuickSightDataSetOutputColumns(apiObjects []*quicksight.OutputColumn) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"description": aws.StringValue(apiObject.Description),
+			"name":        aws.StringValue(apiObject.Name),
+			"type":        aws.StringValue(apiObject.Type),
+		})
+	}
+
+	return tfList
+}
+
+func flattenQuickSightDataSetPhysicalTableMap(apiObjects map[string]*quicksight.PhysicalTable) []interface{} {
+	var tfList []interface{}
+
+	for k, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"physical_table_map_id": k,
+		}
+
+		if v := apiObject.CustomSql; v != nil {
+			tfMap["custom_sql"] = []interface{}{map[string]interface{}{
+				"columns":         flattenQuickSightDataSetInputColumns(v.Columns),
+				"data_source_arn": aws.StringValue(v.DataSourceArn),
+				"name":            aws.StringValue(v.Name),
+				"sql_query":       aws.StringValue(v.SqlQuery),
+			}}
+		}
+
+		if v := apiObject.RelationalTable; v != nil {
+			tfMap["relational_table"] = []interface{}{map[string]interface{}{
+				"catalog":         aws.StringValue(v.Catalog),
+				"data_source_arn": aws.StringValue(v.DataSourceArn),
+				"input_columns":   flattenQuickSightDataSetInputColumns(v.InputColumns),
+				"name":            aws.StringValue(v.Name),
+				"schema":          aws.StringValue(v.Schema),
+			}}
+		}
+
+		if v := apiObject.S3Source; v != nil {
+			s3Source := map[string]interface{}{
+				"data_source_arn": aws.StringValue(v.DataSourceArn),
+				"input_columns":   flattenQuickSightDataSetInputColumns(v.InputColumns),
+			}
+
+			if v := v.UploadSettings; v != nil {
+				s3Source["upload_settings"] = []interface{}{map[string]interface{}{
+					"contains_header": aws.BoolValue(v.ContainsHeader),
+					"delimiter":       aws.StringValue(v.Delimiter),
+					"format":          aws.StringValue(v.Format),
+					"start_from_row":  aws.Int64Value(v.StartFromRow),
+					"text_qualifier":  aws.StringValue(v.TextQualifier),
+				}}
+			}
+
+			tfMap["s3_source"] = []interface{}{s3Source}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenQuickSightDataSetInputColumns(apiObjects []*quicksight.InputColumn) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name": aws.StringValue(apiObject.Name),
+			"type": aws.StringValue(apiObject.Type),
+		})
+	}
+
+	return tfList
+}
+
+func flattenQuickSightDataSetRowLevelPermissionDataSet(apiObject *quicksight.RowLevelPermissionDataSet) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"arn":               aws.StringValue(apiObject.Arn),
+		"format_version":    aws.StringValue(apiObject.FormatVersion),
+		"namespace":         aws.StringValue(apiObject.Namespace),
+		"permission_policy": aws.StringValue(apiObject.PermissionPolicy),
+		"status":            aws.StringValue(apiObject.Status),
+	}}
+}
+
+func flattenQuickSightDataSetRowLevelPermissionTagConfiguration(apiObject *quicksight.RowLevelPermissionTagConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var tagRules []interface{}
+
+	for _, rule := range apiObject.TagRules {
+		if rule == nil {
+			continue
+		}
+
+		tagRules = append(tagRules, map[string]interface{}{
+			"column_name":               aws.StringValue(rule.ColumnName),
+			"match_all_value":           aws.StringValue(rule.MatchAllValue),
+			"tag_key":                   aws.StringValue(rule.TagKey),
+			"tag_multi_value_delimiter": aws.StringValue(rule.TagMultiValueDelimiter),
+		})
+	}
+
+	return []interface{}{map[string]interface{}{
+		"status":    aws.StringValue(apiObject.Status),
+		"tag_rules": tagRules,
+	}}
+}
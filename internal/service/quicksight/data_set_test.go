@@ -0,0 +1,352 @@
+package quicksight_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfquicksight "github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccQuickSightDataSet_basic(t *testing.T) {
+	var dataSet quicksight.DataSet
+	resourceName := "aws_quicksight_data_set.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		ErrorCheck:        acctest.ErrorCheck(t, quicksight.EndpointsID),
+		CheckDestroy:      testAccCheckQuickSightDataSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSetConfig(rId, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightDataSetExists(resourceName, &dataSet),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "quicksight", fmt.Sprintf("dataset/%s", rId)),
+					resource.TestCheckResourceAttr(resourceName, "data_set_id", rId),
+					resource.TestCheckResourceAttr(resourceName, "import_mode", quicksight.DataSetImportModeSpice),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "physical_table_map.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "physical_table_map.*", map[string]string{
+						"physical_table_map_id":            rId,
+						"s3_source.#":                      "1",
+						"s3_source.0.input_columns.#":      "1",
+						"s3_source.0.input_columns.0.name": "Column1",
+						"s3_source.0.input_columns.0.type": quicksight.InputColumnDataTypeString,
+					}),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccQuickSightDataSet_disappears(t *testing.T) {
+	var dataSet quicksight.DataSet
+	resourceName := "aws_quicksight_data_set.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		ErrorCheck:        acctest.ErrorCheck(t, quicksight.EndpointsID),
+		CheckDestroy:      testAccCheckQuickSightDataSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSetConfig(rId, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightDataSetExists(resourceName, &dataSet),
+					acctest.CheckResourceDisappears(acctest.Provider, tfquicksight.ResourceDataSet(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccQuickSightDataSet_logicalTableMap(t *testing.T) {
+	var dataSet quicksight.DataSet
+	resourceName := "aws_quicksight_data_set.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		ErrorCheck:        acctest.ErrorCheck(t, quicksight.EndpointsID),
+		CheckDestroy:      testAccCheckQuickSightDataSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSetLogicalTableMapConfig(rId, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightDataSetExists(resourceName, &dataSet),
+					resource.TestCheckResourceAttr(resourceName, "logical_table_map.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "logical_table_map.*", map[string]string{
+						"alias":                      "Group1",
+						"logical_table_map_id":       rId,
+						"source.#":                   "1",
+						"source.0.physical_table_id": rId,
+						"data_transforms.#":          "1",
+						"data_transforms.0.rename_column_operation.#":                 "1",
+						"data_transforms.0.rename_column_operation.0.column_name":     "Column1",
+						"data_transforms.0.rename_column_operation.0.new_column_name": "Column2",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccQuickSightDataSet_tags(t *testing.T) {
+	var dataSet quicksight.DataSet
+	resourceName := "aws_quicksight_data_set.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		ErrorCheck:        acctest.ErrorCheck(t, quicksight.EndpointsID),
+		CheckDestroy:      testAccCheckQuickSightDataSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSetTags1Config(rId, rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightDataSetExists(resourceName, &dataSet),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDataSetTags2Config(rId, rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightDataSetExists(resourceName, &dataSet),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckQuickSightDataSetExists(resourceName string, dataSet *quicksight.DataSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		awsAccountID, dataSetID, err := tfquicksight.ParseDataSetID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn
+
+		output, err := tfquicksight.FindDataSetByID(context.Background(), conn, awsAccountID, dataSetID)
+
+		if err != nil {
+			return err
+		}
+
+		*dataSet = *output
+
+		return nil
+	}
+}
+
+func testAccCheckQuickSightDataSetDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_quicksight_data_set" {
+			continue
+		}
+
+		awsAccountID, dataSetID, err := tfquicksight.ParseDataSetID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = tfquicksight.FindDataSetByID(context.Background(), conn, awsAccountID, dataSetID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("QuickSight Data Set (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccDataSetConfig(rId, rName string) string {
+	return acctest.ConfigCompose(
+		testAccDataSourceConfig(rId, rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_data_set" "test" {
+  data_set_id = %[1]q
+  name        = %[2]q
+  import_mode = "SPICE"
+
+  physical_table_map {
+    physical_table_map_id = %[1]q
+
+    s3_source {
+      data_source_arn = aws_quicksight_data_source.test.arn
+
+      input_columns {
+        name = "Column1"
+        type = "STRING"
+      }
+
+      upload_settings {
+        format = "JSON"
+      }
+    }
+  }
+}
+`, rId, rName))
+}
+
+func testAccDataSetLogicalTableMapConfig(rId, rName string) string {
+	return acctest.ConfigCompose(
+		testAccDataSourceConfig(rId, rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_data_set" "test" {
+  data_set_id = %[1]q
+  name        = %[2]q
+  import_mode = "SPICE"
+
+  physical_table_map {
+    physical_table_map_id = %[1]q
+
+    s3_source {
+      data_source_arn = aws_quicksight_data_source.test.arn
+
+      input_columns {
+        name = "Column1"
+        type = "STRING"
+      }
+
+      upload_settings {
+        format = "JSON"
+      }
+    }
+  }
+
+  logical_table_map {
+    logical_table_map_id = %[1]q
+    alias                = "Group1"
+
+    source {
+      physical_table_id = %[1]q
+    }
+
+    data_transforms {
+      rename_column_operation {
+        column_name     = "Column1"
+        new_column_name = "Column2"
+      }
+    }
+  }
+}
+`, rId, rName))
+}
+
+func testAccDataSetTags1Config(rId, rName, key1, value1 string) string {
+	return acctest.ConfigCompose(
+		testAccDataSourceConfig(rId, rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_data_set" "test" {
+  data_set_id = %[1]q
+  name        = %[2]q
+  import_mode = "SPICE"
+
+  physical_table_map {
+    physical_table_map_id = %[1]q
+
+    s3_source {
+      data_source_arn = aws_quicksight_data_source.test.arn
+
+      input_columns {
+        name = "Column1"
+        type = "STRING"
+      }
+
+      upload_settings {
+        format = "JSON"
+      }
+    }
+  }
+
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rId, rName, key1, value1))
+}
+
+func testAccDataSetTags2Config(rId, rName, key1, value1, key2, value2 string) string {
+	return acctest.ConfigCompose(
+		testAccDataSourceConfig(rId, rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_data_set" "test" {
+  data_set_id = %[1]q
+  name        = %[2]q
+  import_mode = "SPICE"
+
+  physical_table_map {
+    physical_table_map_id = %[1]q
+
+    s3_source {
+      data_source_arn = aws_quicksight_data_source.test.arn
+
+      input_columns {
+        name = "Column1"
+        type = "STRING"
+      }
+
+      upload_settings {
+        format = "JSON"
+      }
+    }
+  }
+
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+}
+`, rId, rName, key1, value1, key2, value2))
+}
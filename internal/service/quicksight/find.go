@@ -1,8 +1,13 @@
 package quicksight
 
 import (
+	"context"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func FindGroupMembership(conn *quicksight.QuickSight, listInput *quicksight.ListGroupMembershipsInput, userName string) (bool, error) {
@@ -31,3 +36,182 @@ func FindGroupMembership(conn *quicksight.QuickSight, listInput *quicksight.List
 
 	return found, nil
 }
+
+func FindAnalysisByID(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, analysisID string) (*quicksight.Analysis, error) {
+	input := &quicksight.DescribeAnalysisInput{
+		AnalysisId:   aws.String(analysisID),
+		AwsAccountId: aws.String(awsAccountID),
+	}
+
+	output, err := conn.DescribeAnalysisWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Analysis == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	// Deleted analyses remain describable until their recovery window expires.
+	if status := aws.StringValue(output.Analysis.Status); status == quicksight.ResourceStatusDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return output.Analysis, nil
+}
+
+// FindDashboardByID returns the specified dashboard version, or the published version if versionNumber is 0.
+func FindDashboardByID(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, dashboardID string, versionNumber int64) (*quicksight.Dashboard, error) {
+	input := &quicksight.DescribeDashboardInput{
+		AwsAccountId: aws.String(awsAccountID),
+		DashboardId:  aws.String(dashboardID),
+	}
+
+	if versionNumber > 0 {
+		input.VersionNumber = aws.Int64(versionNumber)
+	}
+
+	output, err := conn.DescribeDashboardWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Dashboard == nil || output.Dashboard.Version == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Dashboard, nil
+}
+
+func FindDataSetByID(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, dataSetID string) (*quicksight.DataSet, error) {
+	input := &quicksight.DescribeDataSetInput{
+		AwsAccountId: aws.String(awsAccountID),
+		DataSetId:    aws.String(dataSetID),
+	}
+
+	output, err := conn.DescribeDataSetWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DataSet == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.DataSet, nil
+}
+
+func FindFolderByID(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, folderID string) (*quicksight.Folder, error) {
+	input := &quicksight.DescribeFolderInput{
+		AwsAccountId: aws.String(awsAccountID),
+		FolderId:     aws.String(folderID),
+	}
+
+	output, err := conn.DescribeFolderWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Folder == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Folder, nil
+}
+
+// FindTemplateByID returns the specified template version, or the latest version if versionNumber is 0.
+func FindTemplateByID(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, templateID string, versionNumber int64) (*quicksight.Template, error) {
+	input := &quicksight.DescribeTemplateInput{
+		AwsAccountId: aws.String(awsAccountID),
+		TemplateId:   aws.String(templateID),
+	}
+
+	if versionNumber > 0 {
+		input.VersionNumber = aws.Int64(versionNumber)
+	}
+
+	output, err := conn.DescribeTemplateWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Template == nil || output.Template.Version == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Template, nil
+}
+
+// FindThemeByID returns the specified theme version, or the latest version if versionNumber is 0.
+func FindThemeByID(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, themeID string, versionNumber int64) (*quicksight.Theme, error) {
+	input := &quicksight.DescribeThemeInput{
+		AwsAccountId: aws.String(awsAccountID),
+		ThemeId:      aws.String(themeID),
+	}
+
+	if versionNumber > 0 {
+		input.VersionNumber = aws.Int64(versionNumber)
+	}
+
+	output, err := conn.DescribeThemeWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Theme == nil || output.Theme.Version == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Theme, nil
+}
@@ -0,0 +1,148 @@
+package quicksight
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+func expandQuickSightDataSetReferences(tfList []interface{}) []*quicksight.DataSetReference {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*quicksight.DataSetReference
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &quicksight.DataSetReference{}
+
+		if v, ok := tfMap["data_set_arn"].(string); ok && v != "" {
+			apiObject.DataSetArn = aws.String(v)
+		}
+
+		if v, ok := tfMap["data_set_placeholder"].(string); ok && v != "" {
+			apiObject.DataSetPlaceholder = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+// expandQuickSightSourceTemplate returns the template ARN and data set references of a source_entity block.
+func expandQuickSightSourceTemplate(tfList []interface{}) (*string, []*quicksight.DataSetReference) {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil, nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	v, ok := tfMap["source_template"].([]interface{})
+
+	if !ok || len(v) == 0 || v[0] == nil {
+		return nil, nil
+	}
+
+	tfMap = v[0].(map[string]interface{})
+
+	return aws.String(tfMap["arn"].(string)), expandQuickSightDataSetReferences(tfMap["data_set_references"].([]interface{}))
+}
+
+func expandQuickSightParameters(tfList []interface{}) *quicksight.Parameters {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &quicksight.Parameters{}
+
+	if v, ok := tfMap["date_time_parameters"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap := tfMapRaw.(map[string]interface{})
+			param := &quicksight.DateTimeParameter{
+				Name: aws.String(tfMap["name"].(string)),
+			}
+
+			for _, v := range tfMap["values"].([]interface{}) {
+				t, _ := time.Parse(time.RFC3339, v.(string))
+				param.Values = append(param.Values, aws.Time(t))
+			}
+
+			apiObject.DateTimeParameters = append(apiObject.DateTimeParameters, param)
+		}
+	}
+
+	if v, ok := tfMap["decimal_parameters"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap := tfMapRaw.(map[string]interface{})
+			param := &quicksight.DecimalParameter{
+				Name: aws.String(tfMap["name"].(string)),
+			}
+
+			for _, v := range tfMap["values"].([]interface{}) {
+				param.Values = append(param.Values, aws.Float64(v.(float64)))
+			}
+
+			apiObject.DecimalParameters = append(apiObject.DecimalParameters, param)
+		}
+	}
+
+	if v, ok := tfMap["integer_parameters"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap := tfMapRaw.(map[string]interface{})
+			param := &quicksight.IntegerParameter{
+				Name: aws.String(tfMap["name"].(string)),
+			}
+
+			for _, v := range tfMap["values"].([]interface{}) {
+				param.Values = append(param.Values, aws.Int64(int64(v.(int))))
+			}
+
+			apiObject.IntegerParameters = append(apiObject.IntegerParameters, param)
+		}
+	}
+
+	if v, ok := tfMap["string_parameters"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap := tfMapRaw.(map[string]interface{})
+			param := &quicksight.StringParameter{
+				Name:   aws.String(tfMap["name"].(string)),
+				Values: flex.ExpandStringList(tfMap["values"].([]interface{})),
+			}
+
+			apiObject.StringParameters = append(apiObject.StringParameters, param)
+		}
+	}
+
+	return apiObject
+}
+
+// versionNumberFromARN returns the version number from a dashboard, template or theme version ARN,
+// e.g. arn:aws:quicksight:us-west-2:123456789012:dashboard/example/version/2.
+func versionNumberFromARN(arn string) (int64, error) {
+	i := strings.LastIndex(arn, "/")
+
+	if i == -1 {
+		return 0, fmt.Errorf("unexpected format for version ARN (%s)", arn)
+	}
+
+	v, err := strconv.ParseInt(arn[i+1:], 10, 64)
+
+	if err != nil {
+		return 0, fmt.Errorf("unexpected format for version ARN (%s): %w", arn, err)
+	}
+
+	return v, nil
+}
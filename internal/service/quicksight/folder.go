@@ -0,0 +1,307 @@
+package quicksight
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceFolder() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFolderCreate,
+		ReadWithoutTimeout:   resourceFolderRead,
+		UpdateWithoutTimeout: resourceFolderUpdate,
+		DeleteWithoutTimeout: resourceFolderDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"aws_account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"folder_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+
+			"folder_path": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"folder_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      quicksight.FolderTypeShared,
+				ValidateFunc: validation.StringInSlice(quicksight.FolderType_Values(), false),
+			},
+
+			"last_updated_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 200),
+			},
+
+			"parent_folder_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+
+			"permission": permissionsSchema(),
+
+			"tags": tftags.TagsSchema(),
+
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceFolderCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	awsAccountId := meta.(*conns.AWSClient).AccountID
+	folderId := d.Get("folder_id").(string)
+
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountId = v.(string)
+	}
+
+	input := &quicksight.CreateFolderInput{
+		AwsAccountId: aws.String(awsAccountId),
+		FolderId:     aws.String(folderId),
+		FolderType:   aws.String(d.Get("folder_type").(string)),
+		Name:         aws.String(d.Get("name").(string)),
+	}
+
+	if v, ok := d.GetOk("parent_folder_arn"); ok {
+		input.ParentFolderArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("permission"); ok && v.(*schema.Set).Len() > 0 {
+		input.Permissions = expandQuickSightDataSourcePermissions(v.(*schema.Set).List())
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	_, err := conn.CreateFolderWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating QuickSight Folder (%s): %s", folderId, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", awsAccountId, folderId))
+
+	return resourceFolderRead(ctx, d, meta)
+}
+
+func resourceFolderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	awsAccountId, folderId, err := ParseFolderID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	folder, err := FindFolderByID(ctx, conn, awsAccountId, folderId)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] QuickSight Folder (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading QuickSight Folder (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", folder.Arn)
+	d.Set("aws_account_id", awsAccountId)
+	d.Set("created_time", aws.TimeValue(folder.CreatedTime).Format(time.RFC3339))
+	d.Set("folder_id", folder.FolderId)
+	d.Set("folder_type", folder.FolderType)
+	d.Set("last_updated_time", aws.TimeValue(folder.LastUpdatedTime).Format(time.RFC3339))
+	d.Set("name", folder.Name)
+
+	// The folder path lists the ARNs of all ancestors, the last of which is the parent.
+	if err := d.Set("folder_path", aws.StringValueSlice(folder.FolderPath)); err != nil {
+		return diag.Errorf("error setting folder_path: %s", err)
+	}
+
+	if n := len(folder.FolderPath); n > 0 {
+		d.Set("parent_folder_arn", folder.FolderPath[n-1])
+	} else {
+		d.Set("parent_folder_arn", nil)
+	}
+
+	tags, err := ListTags(conn, d.Get("arn").(string))
+
+	if err != nil {
+		return diag.Errorf("error listing tags for QuickSight Folder (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	permsResp, err := conn.DescribeFolderPermissionsWithContext(ctx, &quicksight.DescribeFolderPermissionsInput{
+		AwsAccountId: aws.String(awsAccountId),
+		FolderId:     aws.String(folderId),
+	})
+
+	if err != nil {
+		return diag.Errorf("error describing QuickSight Folder (%s) Permissions: %s", d.Id(), err)
+	}
+
+	if err := d.Set("permission", flattenQuickSightPermissions(permsResp.Permissions)); err != nil {
+		return diag.Errorf("error setting permission: %s", err)
+	}
+
+	return nil
+}
+
+func resourceFolderUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn
+
+	awsAccountId, folderId, err := ParseFolderID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("name") {
+		_, err := conn.UpdateFolderWithContext(ctx, &quicksight.UpdateFolderInput{
+			AwsAccountId: aws.String(awsAccountId),
+			FolderId:     aws.String(folderId),
+			Name:         aws.String(d.Get("name").(string)),
+		})
+
+		if err != nil {
+			return diag.Errorf("error updating QuickSight Folder (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("permission") {
+		o, n := d.GetChange("permission")
+		toGrant, toRevoke := DiffPermissions(o.(*schema.Set).List(), n.(*schema.Set).List())
+
+		input := &quicksight.UpdateFolderPermissionsInput{
+			AwsAccountId: aws.String(awsAccountId),
+			FolderId:     aws.String(folderId),
+		}
+
+		if len(toGrant) > 0 {
+			input.GrantPermissions = toGrant
+		}
+
+		if len(toRevoke) > 0 {
+			input.RevokePermissions = toRevoke
+		}
+
+		_, err = conn.UpdateFolderPermissionsWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating QuickSight Folder (%s) permissions: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating QuickSight Folder (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceFolderRead(ctx, d, meta)
+}
+
+func resourceFolderDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn
+
+	awsAccountId, folderId, err := ParseFolderID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting QuickSight Folder: %s", d.Id())
+	_, err = conn.DeleteFolderWithContext(ctx, &quicksight.DeleteFolderInput{
+		AwsAccountId: aws.String(awsAccountId),
+		FolderId:     aws.String(folderId),
+	})
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting QuickSight Folder (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func ParseFolderID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected AWS_ACCOUNT_ID/FOLDER_ID", id)
+	}
+	return parts[0], parts[1], nil
+}
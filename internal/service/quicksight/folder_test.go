@@ -0,0 +1,259 @@
+package quicksight_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfquicksight "github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccQuickSightFolder_basic(t *testing.T) {
+	var folder quicksight.Folder
+	resourceName := "aws_quicksight_folder.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		ErrorCheck:        acctest.ErrorCheck(t, quicksight.EndpointsID),
+		CheckDestroy:      testAccCheckQuickSightFolderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFolderConfig(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightFolderExists(resourceName, &folder),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "quicksight", fmt.Sprintf("folder/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "folder_id", rName),
+					resource.TestCheckResourceAttr(resourceName, "folder_path.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "folder_type", quicksight.FolderTypeShared),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFolderConfig(rName, rName+"-updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightFolderExists(resourceName, &folder),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"-updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccQuickSightFolder_disappears(t *testing.T) {
+	var folder quicksight.Folder
+	resourceName := "aws_quicksight_folder.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		ErrorCheck:        acctest.ErrorCheck(t, quicksight.EndpointsID),
+		CheckDestroy:      testAccCheckQuickSightFolderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFolderConfig(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightFolderExists(resourceName, &folder),
+					acctest.CheckResourceDisappears(acctest.Provider, tfquicksight.ResourceFolder(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccQuickSightFolder_parentFolder(t *testing.T) {
+	var folder quicksight.Folder
+	resourceName := "aws_quicksight_folder.test"
+	parentResourceName := "aws_quicksight_folder.parent"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		ErrorCheck:        acctest.ErrorCheck(t, quicksight.EndpointsID),
+		CheckDestroy:      testAccCheckQuickSightFolderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFolderParentFolderConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightFolderExists(resourceName, &folder),
+					resource.TestCheckResourceAttr(resourceName, "folder_path.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "folder_path.0", parentResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "parent_folder_arn", parentResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccQuickSightFolder_tags(t *testing.T) {
+	var folder quicksight.Folder
+	resourceName := "aws_quicksight_folder.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		ErrorCheck:        acctest.ErrorCheck(t, quicksight.EndpointsID),
+		CheckDestroy:      testAccCheckQuickSightFolderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFolderTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightFolderExists(resourceName, &folder),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFolderTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightFolderExists(resourceName, &folder),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccFolderTags1Config(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightFolderExists(resourceName, &folder),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckQuickSightFolderExists(resourceName string, folder *quicksight.Folder) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		awsAccountID, folderID, err := tfquicksight.ParseFolderID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn
+
+		output, err := tfquicksight.FindFolderByID(context.Background(), conn, awsAccountID, folderID)
+
+		if err != nil {
+			return err
+		}
+
+		*folder = *output
+
+		return nil
+	}
+}
+
+func testAccCheckQuickSightFolderDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_quicksight_folder" {
+			continue
+		}
+
+		awsAccountID, folderID, err := tfquicksight.ParseFolderID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = tfquicksight.FindFolderByID(context.Background(), conn, awsAccountID, folderID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("QuickSight Folder (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccFolderConfig(rId, rName string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_folder" "test" {
+  folder_id = %[1]q
+  name      = %[2]q
+}
+`, rId, rName)
+}
+
+func testAccFolderParentFolderConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_folder" "parent" {
+  folder_id = "%[1]s-parent"
+  name      = "%[1]s-parent"
+}
+
+resource "aws_quicksight_folder" "test" {
+  folder_id         = %[1]q
+  name              = %[1]q
+  parent_folder_arn = aws_quicksight_folder.parent.arn
+}
+`, rName)
+}
+
+func testAccFolderTags1Config(rName, key1, value1 string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_folder" "test" {
+  folder_id = %[1]q
+  name      = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, key1, value1)
+}
+
+func testAccFolderTags2Config(rName, key1, value1, key2, value2 string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_folder" "test" {
+  folder_id = %[1]q
+  name      = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, key1, value1, key2, value2)
+}
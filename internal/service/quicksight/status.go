@@ -6,6 +6,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/quicksight"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 // status fetches the DataSource and its Status
@@ -29,3 +30,67 @@ func status(ctx context.Context, conn *quicksight.QuickSight, accountId, datasou
 		return output.DataSource, aws.StringValue(output.DataSource.Status), nil
 	}
 }
+
+func statusAnalysis(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, analysisID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindAnalysisByID(ctx, conn, awsAccountID, analysisID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusDashboard(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, dashboardID string, versionNumber int64) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDashboardByID(ctx, conn, awsAccountID, dashboardID, versionNumber)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Version.Status), nil
+	}
+}
+
+func statusTemplate(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, templateID string, versionNumber int64) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindTemplateByID(ctx, conn, awsAccountID, templateID, versionNumber)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Version.Status), nil
+	}
+}
+
+func statusTheme(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, themeID string, versionNumber int64) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindThemeByID(ctx, conn, awsAccountID, themeID, versionNumber)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Version.Status), nil
+	}
+}
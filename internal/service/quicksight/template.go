@@ -0,0 +1,389 @@
+package quicksight
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTemplateCreate,
+		ReadWithoutTimeout:   resourceTemplateRead,
+		UpdateWithoutTimeout: resourceTemplateUpdate,
+		DeleteWithoutTimeout: resourceTemplateDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"aws_account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"last_updated_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+
+			"permission": permissionsSchema(),
+
+			"source_entity": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source_analysis": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"source_entity.0.source_analysis", "source_entity.0.source_template"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"data_set_references": dataSetReferencesSchema(),
+								},
+							},
+						},
+						"source_template": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"source_entity.0.source_analysis", "source_entity.0.source_template"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"source_entity_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tftags.TagsSchema(),
+
+			"tags_all": tftags.TagsSchemaComputed(),
+
+			"template_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+
+			"version_description": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 512),
+			},
+
+			"version_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	awsAccountId := meta.(*conns.AWSClient).AccountID
+	templateId := d.Get("template_id").(string)
+
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountId = v.(string)
+	}
+
+	input := &quicksight.CreateTemplateInput{
+		AwsAccountId:       aws.String(awsAccountId),
+		Name:               aws.String(d.Get("name").(string)),
+		SourceEntity:       expandQuickSightTemplateSourceEntity(d.Get("source_entity").([]interface{})),
+		TemplateId:         aws.String(templateId),
+		VersionDescription: aws.String(d.Get("version_description").(string)),
+	}
+
+	if v, ok := d.GetOk("permission"); ok && v.(*schema.Set).Len() > 0 {
+		input.Permissions = expandQuickSightDataSourcePermissions(v.(*schema.Set).List())
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateTemplateWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating QuickSight Template (%s): %s", templateId, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", awsAccountId, templateId))
+
+	versionNumber, err := versionNumberFromARN(aws.StringValue(output.VersionArn))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := waitTemplateCreated(ctx, conn, awsAccountId, templateId, versionNumber); err != nil {
+		return diag.Errorf("error waiting for QuickSight Template (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceTemplateRead(ctx, d, meta)
+}
+
+func resourceTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	awsAccountId, templateId, err := ParseTemplateID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	template, err := FindTemplateByID(ctx, conn, awsAccountId, templateId, 0)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] QuickSight Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading QuickSight Template (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", template.Arn)
+	d.Set("aws_account_id", awsAccountId)
+	d.Set("created_time", aws.TimeValue(template.CreatedTime).Format(time.RFC3339))
+	d.Set("last_updated_time", aws.TimeValue(template.LastUpdatedTime).Format(time.RFC3339))
+	d.Set("name", template.Name)
+	d.Set("source_entity_arn", template.Version.SourceEntityArn)
+	d.Set("status", template.Version.Status)
+	d.Set("template_id", template.TemplateId)
+	d.Set("version_description", template.Version.Description)
+	d.Set("version_number", template.Version.VersionNumber)
+
+	tags, err := ListTags(conn, d.Get("arn").(string))
+
+	if err != nil {
+		return diag.Errorf("error listing tags for QuickSight Template (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	permsResp, err := conn.DescribeTemplatePermissionsWithContext(ctx, &quicksight.DescribeTemplatePermissionsInput{
+		AwsAccountId: aws.String(awsAccountId),
+		TemplateId:   aws.String(templateId),
+	})
+
+	if err != nil {
+		return diag.Errorf("error describing QuickSight Template (%s) Permissions: %s", d.Id(), err)
+	}
+
+	if err := d.Set("permission", flattenQuickSightPermissions(permsResp.Permissions)); err != nil {
+		return diag.Errorf("error setting permission: %s", err)
+	}
+
+	return nil
+}
+
+func resourceTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn
+
+	awsAccountId, templateId, err := ParseTemplateID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChangesExcept("permission", "tags", "tags_all") {
+		input := &quicksight.UpdateTemplateInput{
+			AwsAccountId:       aws.String(awsAccountId),
+			Name:               aws.String(d.Get("name").(string)),
+			SourceEntity:       expandQuickSightTemplateSourceEntity(d.Get("source_entity").([]interface{})),
+			TemplateId:         aws.String(templateId),
+			VersionDescription: aws.String(d.Get("version_description").(string)),
+		}
+
+		output, err := conn.UpdateTemplateWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating QuickSight Template (%s): %s", d.Id(), err)
+		}
+
+		versionNumber, err := versionNumberFromARN(aws.StringValue(output.VersionArn))
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if _, err := waitTemplateUpdated(ctx, conn, awsAccountId, templateId, versionNumber); err != nil {
+			return diag.Errorf("error waiting for QuickSight Template (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("permission") {
+		o, n := d.GetChange("permission")
+		toGrant, toRevoke := DiffPermissions(o.(*schema.Set).List(), n.(*schema.Set).List())
+
+		input := &quicksight.UpdateTemplatePermissionsInput{
+			AwsAccountId: aws.String(awsAccountId),
+			TemplateId:   aws.String(templateId),
+		}
+
+		if len(toGrant) > 0 {
+			input.GrantPermissions = toGrant
+		}
+
+		if len(toRevoke) > 0 {
+			input.RevokePermissions = toRevoke
+		}
+
+		_, err = conn.UpdateTemplatePermissionsWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating QuickSight Template (%s) permissions: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating QuickSight Template (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceTemplateRead(ctx, d, meta)
+}
+
+func resourceTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn
+
+	awsAccountId, templateId, err := ParseTemplateID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting QuickSight Template: %s", d.Id())
+	_, err = conn.DeleteTemplateWithContext(ctx, &quicksight.DeleteTemplateInput{
+		AwsAccountId: aws.String(awsAccountId),
+		TemplateId:   aws.String(templateId),
+	})
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting QuickSight Template (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandQuickSightTemplateSourceEntity(tfList []interface{}) *quicksight.TemplateSourceEntity {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &quicksight.TemplateSourceEntity{}
+
+	if v, ok := tfMap["source_analysis"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.SourceAnalysis = &quicksight.TemplateSourceAnalysis{
+			Arn:               aws.String(tfMap["arn"].(string)),
+			DataSetReferences: expandQuickSightDataSetReferences(tfMap["data_set_references"].([]interface{})),
+		}
+	}
+
+	if v, ok := tfMap["source_template"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.SourceTemplate = &quicksight.TemplateSourceTemplate{
+			Arn: aws.String(tfMap["arn"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func ParseTemplateID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected AWS_ACCOUNT_ID/TEMPLATE_ID", id)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,169 @@
+package quicksight_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfquicksight "github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Templates can only be created from an existing analysis or template, neither of which
+// can be created from scratch through the API. The tests for templates, analyses and
+// dashboards therefore require a pre-existing template.
+func testAccSourceTemplateFromEnv(t *testing.T) (string, string) {
+	templateARN := os.Getenv("AWS_QUICKSIGHT_SOURCE_TEMPLATE_ARN")
+	placeholder := os.Getenv("AWS_QUICKSIGHT_SOURCE_TEMPLATE_DATA_SET_PLACEHOLDER")
+
+	if templateARN == "" || placeholder == "" {
+		t.Skip("Environment variables AWS_QUICKSIGHT_SOURCE_TEMPLATE_ARN and AWS_QUICKSIGHT_SOURCE_TEMPLATE_DATA_SET_PLACEHOLDER are not set")
+	}
+
+	return templateARN, placeholder
+}
+
+func TestAccQuickSightTemplate_basic(t *testing.T) {
+	var template quicksight.Template
+	resourceName := "aws_quicksight_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	sourceTemplateARN, _ := testAccSourceTemplateFromEnv(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		ErrorCheck:        acctest.ErrorCheck(t, quicksight.EndpointsID),
+		CheckDestroy:      testAccCheckQuickSightTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateConfig(rName, "1", sourceTemplateARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightTemplateExists(resourceName, &template),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "quicksight", fmt.Sprintf("template/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "source_entity_arn", sourceTemplateARN),
+					resource.TestCheckResourceAttr(resourceName, "status", quicksight.ResourceStatusCreationSuccessful),
+					resource.TestCheckResourceAttr(resourceName, "template_id", rName),
+					resource.TestCheckResourceAttr(resourceName, "version_description", "1"),
+					resource.TestCheckResourceAttr(resourceName, "version_number", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source_entity"},
+			},
+			{
+				Config: testAccTemplateConfig(rName, "2", sourceTemplateARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightTemplateExists(resourceName, &template),
+					resource.TestCheckResourceAttr(resourceName, "version_description", "2"),
+					resource.TestCheckResourceAttr(resourceName, "version_number", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccQuickSightTemplate_disappears(t *testing.T) {
+	var template quicksight.Template
+	resourceName := "aws_quicksight_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	sourceTemplateARN, _ := testAccSourceTemplateFromEnv(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		ErrorCheck:        acctest.ErrorCheck(t, quicksight.EndpointsID),
+		CheckDestroy:      testAccCheckQuickSightTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateConfig(rName, "1", sourceTemplateARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightTemplateExists(resourceName, &template),
+					acctest.CheckResourceDisappears(acctest.Provider, tfquicksight.ResourceTemplate(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckQuickSightTemplateExists(resourceName string, template *quicksight.Template) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		awsAccountID, templateID, err := tfquicksight.ParseTemplateID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn
+
+		output, err := tfquicksight.FindTemplateByID(context.Background(), conn, awsAccountID, templateID, 0)
+
+		if err != nil {
+			return err
+		}
+
+		*template = *output
+
+		return nil
+	}
+}
+
+func testAccCheckQuickSightTemplateDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_quicksight_template" {
+			continue
+		}
+
+		awsAccountID, templateID, err := tfquicksight.ParseTemplateID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = tfquicksight.FindTemplateByID(context.Background(), conn, awsAccountID, templateID, 0)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("QuickSight Template (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccTemplateConfig(rName, versionDescription, sourceTemplateARN string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_template" "test" {
+  template_id         = %[1]q
+  name                = %[1]q
+  version_description = %[2]q
+
+  source_entity {
+    source_template {
+      arn = %[3]q
+    }
+  }
+}
+`, rName, versionDescription, sourceTemplateARN)
+}
@@ -0,0 +1,643 @@
+package quicksight
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+var themeColorRegexp = regexp.MustCompile(`^#[0-9A-F]{6}$`)
+
+func ResourceTheme() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceThemeCreate,
+		ReadWithoutTimeout:   resourceThemeRead,
+		UpdateWithoutTimeout: resourceThemeUpdate,
+		DeleteWithoutTimeout: resourceThemeDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"aws_account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+
+			"base_theme_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+
+			"configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"data_color_palette": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"colors": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MinItems: 8,
+										MaxItems: 20,
+										Elem:     themeColorSchema(),
+									},
+									"empty_fill_color": themeOptionalColorSchema(),
+									"min_max_gradient": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MinItems: 2,
+										MaxItems: 2,
+										Elem:     themeColorSchema(),
+									},
+								},
+							},
+						},
+						"sheet": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"tile": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"border": themeShowSchema(),
+											},
+										},
+									},
+									"tile_layout": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"gutter": themeShowSchema(),
+												"margin": themeShowSchema(),
+											},
+										},
+									},
+								},
+							},
+						},
+						"ui_color_palette": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"accent":               themeOptionalColorSchema(),
+									"accent_foreground":    themeOptionalColorSchema(),
+									"danger":               themeOptionalColorSchema(),
+									"danger_foreground":    themeOptionalColorSchema(),
+									"dimension":            themeOptionalColorSchema(),
+									"dimension_foreground": themeOptionalColorSchema(),
+									"measure":              themeOptionalColorSchema(),
+									"measure_foreground":   themeOptionalColorSchema(),
+									"primary_background":   themeOptionalColorSchema(),
+									"primary_foreground":   themeOptionalColorSchema(),
+									"secondary_background": themeOptionalColorSchema(),
+									"secondary_foreground": themeOptionalColorSchema(),
+									"success":              themeOptionalColorSchema(),
+									"success_foreground":   themeOptionalColorSchema(),
+									"warning":              themeOptionalColorSchema(),
+									"warning_foreground":   themeOptionalColorSchema(),
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"last_updated_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+
+			"permission": permissionsSchema(),
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tftags.TagsSchema(),
+
+			"tags_all": tftags.TagsSchemaComputed(),
+
+			"theme_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+
+			"version_description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 512),
+			},
+
+			"version_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func themeColorSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		ValidateFunc: validation.StringMatch(themeColorRegexp, "must be a hexadecimal color code, e.g. #FFFFFF"),
+	}
+}
+
+func themeOptionalColorSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.StringMatch(themeColorRegexp, "must be a hexadecimal color code, e.g. #FFFFFF"),
+	}
+}
+
+func themeShowSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"show": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceThemeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	awsAccountId := meta.(*conns.AWSClient).AccountID
+	themeId := d.Get("theme_id").(string)
+
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountId = v.(string)
+	}
+
+	input := &quicksight.CreateThemeInput{
+		AwsAccountId: aws.String(awsAccountId),
+		BaseThemeId:  aws.String(d.Get("base_theme_id").(string)),
+		// Configuration is required on create, even if empty.
+		Configuration: &quicksight.ThemeConfiguration{},
+		Name:          aws.String(d.Get("name").(string)),
+		ThemeId:       aws.String(themeId),
+	}
+
+	if v, ok := d.GetOk("configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Configuration = expandQuickSightThemeConfiguration(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("permission"); ok && v.(*schema.Set).Len() > 0 {
+		input.Permissions = expandQuickSightDataSourcePermissions(v.(*schema.Set).List())
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	if v, ok := d.GetOk("version_description"); ok {
+		input.VersionDescription = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateThemeWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating QuickSight Theme (%s): %s", themeId, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", awsAccountId, themeId))
+
+	versionNumber, err := versionNumberFromARN(aws.StringValue(output.VersionArn))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := waitThemeCreated(ctx, conn, awsAccountId, themeId, versionNumber); err != nil {
+		return diag.Errorf("error waiting for QuickSight Theme (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceThemeRead(ctx, d, meta)
+}
+
+func resourceThemeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	awsAccountId, themeId, err := ParseThemeID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	theme, err := FindThemeByID(ctx, conn, awsAccountId, themeId, 0)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] QuickSight Theme (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading QuickSight Theme (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", theme.Arn)
+	d.Set("aws_account_id", awsAccountId)
+	d.Set("base_theme_id", theme.Version.BaseThemeId)
+	d.Set("created_time", aws.TimeValue(theme.CreatedTime).Format(time.RFC3339))
+	d.Set("last_updated_time", aws.TimeValue(theme.LastUpdatedTime).Format(time.RFC3339))
+	d.Set("name", theme.Name)
+	d.Set("status", theme.Version.Status)
+	d.Set("theme_id", theme.ThemeId)
+	d.Set("version_description", theme.Version.Description)
+	d.Set("version_number", theme.Version.VersionNumber)
+
+	if err := d.Set("configuration", flattenQuickSightThemeConfiguration(theme.Version.Configuration)); err != nil {
+		return diag.Errorf("error setting configuration: %s", err)
+	}
+
+	tags, err := ListTags(conn, d.Get("arn").(string))
+
+	if err != nil {
+		return diag.Errorf("error listing tags for QuickSight Theme (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	permsResp, err := conn.DescribeThemePermissionsWithContext(ctx, &quicksight.DescribeThemePermissionsInput{
+		AwsAccountId: aws.String(awsAccountId),
+		ThemeId:      aws.String(themeId),
+	})
+
+	if err != nil {
+		return diag.Errorf("error describing QuickSight Theme (%s) Permissions: %s", d.Id(), err)
+	}
+
+	if err := d.Set("permission", flattenQuickSightPermissions(permsResp.Permissions)); err != nil {
+		return diag.Errorf("error setting permission: %s", err)
+	}
+
+	return nil
+}
+
+func resourceThemeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn
+
+	awsAccountId, themeId, err := ParseThemeID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChangesExcept("permission", "tags", "tags_all") {
+		input := &quicksight.UpdateThemeInput{
+			AwsAccountId:  aws.String(awsAccountId),
+			BaseThemeId:   aws.String(d.Get("base_theme_id").(string)),
+			Configuration: expandQuickSightThemeConfiguration(d.Get("configuration").([]interface{})),
+			Name:          aws.String(d.Get("name").(string)),
+			ThemeId:       aws.String(themeId),
+		}
+
+		if v, ok := d.GetOk("version_description"); ok {
+			input.VersionDescription = aws.String(v.(string))
+		}
+
+		output, err := conn.UpdateThemeWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating QuickSight Theme (%s): %s", d.Id(), err)
+		}
+
+		versionNumber, err := versionNumberFromARN(aws.StringValue(output.VersionArn))
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if _, err := waitThemeUpdated(ctx, conn, awsAccountId, themeId, versionNumber); err != nil {
+			return diag.Errorf("error waiting for QuickSight Theme (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("permission") {
+		o, n := d.GetChange("permission")
+		toGrant, toRevoke := DiffPermissions(o.(*schema.Set).List(), n.(*schema.Set).List())
+
+		input := &quicksight.UpdateThemePermissionsInput{
+			AwsAccountId: aws.String(awsAccountId),
+			ThemeId:      aws.String(themeId),
+		}
+
+		if len(toGrant) > 0 {
+			input.GrantPermissions = toGrant
+		}
+
+		if len(toRevoke) > 0 {
+			input.RevokePermissions = toRevoke
+		}
+
+		_, err = conn.UpdateThemePermissionsWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating QuickSight Theme (%s) permissions: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating QuickSight Theme (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceThemeRead(ctx, d, meta)
+}
+
+func resourceThemeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn
+
+	awsAccountId, themeId, err := ParseThemeID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting QuickSight Theme: %s", d.Id())
+	_, err = conn.DeleteThemeWithContext(ctx, &quicksight.DeleteThemeInput{
+		AwsAccountId: aws.String(awsAccountId),
+		ThemeId:      aws.String(themeId),
+	})
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting QuickSight Theme (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandQuickSightThemeConfiguration(tfList []interface{}) *quicksight.ThemeConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &quicksight.ThemeConfiguration{}
+
+	if v, ok := tfMap["data_color_palette"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		palette := &quicksight.DataColorPalette{}
+
+		if v, ok := tfMap["colors"].([]interface{}); ok && len(v) > 0 {
+			palette.Colors = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["empty_fill_color"].(string); ok && v != "" {
+			palette.EmptyFillColor = aws.String(v)
+		}
+
+		if v, ok := tfMap["min_max_gradient"].([]interface{}); ok && len(v) > 0 {
+			palette.MinMaxGradient = flex.ExpandStringList(v)
+		}
+
+		apiObject.DataColorPalette = palette
+	}
+
+	if v, ok := tfMap["sheet"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		sheet := &quicksight.SheetStyle{}
+
+		if v, ok := tfMap["tile"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			sheet.Tile = &quicksight.TileStyle{}
+
+			if v := expandQuickSightThemeShow(tfMap["border"]); v != nil {
+				sheet.Tile.Border = &quicksight.BorderStyle{Show: v}
+			}
+		}
+
+		if v, ok := tfMap["tile_layout"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			sheet.TileLayout = &quicksight.TileLayoutStyle{}
+
+			if v := expandQuickSightThemeShow(tfMap["gutter"]); v != nil {
+				sheet.TileLayout.Gutter = &quicksight.GutterStyle{Show: v}
+			}
+
+			if v := expandQuickSightThemeShow(tfMap["margin"]); v != nil {
+				sheet.TileLayout.Margin = &quicksight.MarginStyle{Show: v}
+			}
+		}
+
+		apiObject.Sheet = sheet
+	}
+
+	if v, ok := tfMap["ui_color_palette"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		palette := &quicksight.UIColorPalette{}
+
+		for k, p := range map[string]**string{
+			"accent":               &palette.Accent,
+			"accent_foreground":    &palette.AccentForeground,
+			"danger":               &palette.Danger,
+			"danger_foreground":    &palette.DangerForeground,
+			"dimension":            &palette.Dimension,
+			"dimension_foreground": &palette.DimensionForeground,
+			"measure":              &palette.Measure,
+			"measure_foreground":   &palette.MeasureForeground,
+			"primary_background":   &palette.PrimaryBackground,
+			"primary_foreground":   &palette.PrimaryForeground,
+			"secondary_background": &palette.SecondaryBackground,
+			"secondary_foreground": &palette.SecondaryForeground,
+			"success":              &palette.Success,
+			"success_foreground":   &palette.SuccessForeground,
+			"warning":              &palette.Warning,
+			"warning_foreground":   &palette.WarningForeground,
+		} {
+			if v, ok := tfMap[k].(string); ok && v != "" {
+				*p = aws.String(v)
+			}
+		}
+
+		apiObject.UIColorPalette = palette
+	}
+
+	return apiObject
+}
+
+func expandQuickSightThemeShow(tfRaw interface{}) *bool {
+	tfList, ok := tfRaw.([]interface{})
+
+	if !ok || len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	return aws.Bool(tfList[0].(map[string]interface{})["show"].(bool))
+}
+
+func flattenQuickSightThemeConfiguration(apiObject *quicksight.ThemeConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.DataColorPalette; v != nil {
+		tfMap["data_color_palette"] = []interface{}{map[string]interface{}{
+			"colors":           aws.StringValueSlice(v.Colors),
+			"empty_fill_color": aws.StringValue(v.EmptyFillColor),
+			"min_max_gradient": aws.StringValueSlice(v.MinMaxGradient),
+		}}
+	}
+
+	if v := apiObject.Sheet; v != nil {
+		sheet := map[string]interface{}{}
+
+		if v := v.Tile; v != nil && v.Border != nil {
+			sheet["tile"] = []interface{}{map[string]interface{}{
+				"border": flattenQuickSightThemeShow(v.Border.Show),
+			}}
+		}
+
+		if v := v.TileLayout; v != nil {
+			tileLayout := map[string]interface{}{}
+
+			if v.Gutter != nil {
+				tileLayout["gutter"] = flattenQuickSightThemeShow(v.Gutter.Show)
+			}
+
+			if v.Margin != nil {
+				tileLayout["margin"] = flattenQuickSightThemeShow(v.Margin.Show)
+			}
+
+			sheet["tile_layout"] = []interface{}{tileLayout}
+		}
+
+		tfMap["sheet"] = []interface{}{sheet}
+	}
+
+	if v := apiObject.UIColorPalette; v != nil {
+		tfMap["ui_color_palette"] = []interface{}{map[string]interface{}{
+			"accent":               aws.StringValue(v.Accent),
+			"accent_foreground":    aws.StringValue(v.AccentForeground),
+			"danger":               aws.StringValue(v.Danger),
+			"danger_foreground":    aws.StringValue(v.DangerForeground),
+			"dimension":            aws.StringValue(v.Dimension),
+			"dimension_foreground": aws.StringValue(v.DimensionForeground),
+			"measure":              aws.StringValue(v.Measure),
+			"measure_foreground":   aws.StringValue(v.MeasureForeground),
+			"primary_background":   aws.StringValue(v.PrimaryBackground),
+			"primary_foreground":   aws.StringValue(v.PrimaryForeground),
+			"secondary_background": aws.StringValue(v.SecondaryBackground),
+			"secondary_foreground": aws.StringValue(v.SecondaryForeground),
+			"success":              aws.StringValue(v.Success),
+			"success_foreground":   aws.StringValue(v.SuccessForeground),
+			"warning":              aws.StringValue(v.Warning),
+			"warning_foreground":   aws.StringValue(v.WarningForeground),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenQuickSightThemeShow(show *bool) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"show": aws.BoolValue(show),
+	}}
+}
+
+func ParseThemeID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected AWS_ACCOUNT_ID/THEME_ID", id)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,160 @@
+package quicksight_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfquicksight "github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccQuickSightTheme_basic(t *testing.T) {
+	var theme quicksight.Theme
+	resourceName := "aws_quicksight_theme.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		ErrorCheck:        acctest.ErrorCheck(t, quicksight.EndpointsID),
+		CheckDestroy:      testAccCheckQuickSightThemeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccThemeConfig(rName, "#FFFFFF"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightThemeExists(resourceName, &theme),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "quicksight", fmt.Sprintf("theme/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "base_theme_id", "MIDNIGHT"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.ui_color_palette.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.ui_color_palette.0.primary_background", "#FFFFFF"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", quicksight.ResourceStatusCreationSuccessful),
+					resource.TestCheckResourceAttr(resourceName, "theme_id", rName),
+					resource.TestCheckResourceAttr(resourceName, "version_number", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccThemeConfig(rName, "#000000"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightThemeExists(resourceName, &theme),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.ui_color_palette.0.primary_background", "#000000"),
+					resource.TestCheckResourceAttr(resourceName, "version_number", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccQuickSightTheme_disappears(t *testing.T) {
+	var theme quicksight.Theme
+	resourceName := "aws_quicksight_theme.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		ErrorCheck:        acctest.ErrorCheck(t, quicksight.EndpointsID),
+		CheckDestroy:      testAccCheckQuickSightThemeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccThemeConfig(rName, "#FFFFFF"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightThemeExists(resourceName, &theme),
+					acctest.CheckResourceDisappears(acctest.Provider, tfquicksight.ResourceTheme(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckQuickSightThemeExists(resourceName string, theme *quicksight.Theme) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		awsAccountID, themeID, err := tfquicksight.ParseThemeID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn
+
+		output, err := tfquicksight.FindThemeByID(context.Background(), conn, awsAccountID, themeID, 0)
+
+		if err != nil {
+			return err
+		}
+
+		*theme = *output
+
+		return nil
+	}
+}
+
+func testAccCheckQuickSightThemeDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_quicksight_theme" {
+			continue
+		}
+
+		awsAccountID, themeID, err := tfquicksight.ParseThemeID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = tfquicksight.FindThemeByID(context.Background(), conn, awsAccountID, themeID, 0)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("QuickSight Theme (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccThemeConfig(rName, primaryBackground string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_theme" "test" {
+  theme_id            = %[1]q
+  name                = %[1]q
+  base_theme_id       = "MIDNIGHT"
+  version_description = %[2]q
+
+  configuration {
+    data_color_palette {
+      colors           = ["#FFFFFF", "#111111", "#222222", "#333333", "#444444", "#555555", "#666666", "#777777"]
+      empty_fill_color = "#FFFFFF"
+      min_max_gradient = ["#FFFFFF", "#111111"]
+    }
+
+    ui_color_palette {
+      primary_background = %[2]q
+    }
+  }
+}
+`, rName, primaryBackground)
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
@@ -14,6 +15,14 @@ import (
 const (
 	dataSourceCreateTimeout = 5 * time.Minute
 	dataSourceUpdateTimeout = 5 * time.Minute
+	analysisCreateTimeout   = 5 * time.Minute
+	analysisUpdateTimeout   = 5 * time.Minute
+	dashboardCreateTimeout  = 5 * time.Minute
+	dashboardUpdateTimeout  = 5 * time.Minute
+	templateCreateTimeout   = 5 * time.Minute
+	templateUpdateTimeout   = 5 * time.Minute
+	themeCreateTimeout      = 5 * time.Minute
+	themeUpdateTimeout      = 5 * time.Minute
 )
 
 // waitCreated waits for a DataSource to return CREATION_SUCCESSFUL
@@ -59,3 +68,227 @@ func waitUpdated(ctx context.Context, conn *quicksight.QuickSight, accountId, da
 
 	return nil, err
 }
+
+// waitAnalysisCreated waits for an Analysis to return CREATION_SUCCESSFUL
+func waitAnalysisCreated(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, analysisID string) (*quicksight.Analysis, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{quicksight.ResourceStatusCreationInProgress},
+		Target:  []string{quicksight.ResourceStatusCreationSuccessful},
+		Refresh: statusAnalysis(ctx, conn, awsAccountID, analysisID),
+		Timeout: analysisCreateTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*quicksight.Analysis); ok {
+		if status := aws.StringValue(output.Status); status == quicksight.ResourceStatusCreationFailed {
+			var errs *multierror.Error
+
+			for _, v := range output.Errors {
+				errs = multierror.Append(errs, fmt.Errorf("%s: %s", aws.StringValue(v.Type), aws.StringValue(v.Message)))
+			}
+
+			tfresource.SetLastError(err, errs.ErrorOrNil())
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// waitAnalysisUpdated waits for an Analysis to return UPDATE_SUCCESSFUL
+func waitAnalysisUpdated(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, analysisID string) (*quicksight.Analysis, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{quicksight.ResourceStatusUpdateInProgress},
+		Target:  []string{quicksight.ResourceStatusUpdateSuccessful},
+		Refresh: statusAnalysis(ctx, conn, awsAccountID, analysisID),
+		Timeout: analysisUpdateTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*quicksight.Analysis); ok {
+		if status := aws.StringValue(output.Status); status == quicksight.ResourceStatusUpdateFailed {
+			var errs *multierror.Error
+
+			for _, v := range output.Errors {
+				errs = multierror.Append(errs, fmt.Errorf("%s: %s", aws.StringValue(v.Type), aws.StringValue(v.Message)))
+			}
+
+			tfresource.SetLastError(err, errs.ErrorOrNil())
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// waitDashboardCreated waits for a Dashboard version to return CREATION_SUCCESSFUL
+func waitDashboardCreated(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, dashboardID string, versionNumber int64) (*quicksight.Dashboard, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{quicksight.ResourceStatusCreationInProgress},
+		Target:  []string{quicksight.ResourceStatusCreationSuccessful},
+		Refresh: statusDashboard(ctx, conn, awsAccountID, dashboardID, versionNumber),
+		Timeout: dashboardCreateTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*quicksight.Dashboard); ok {
+		if status := aws.StringValue(output.Version.Status); status == quicksight.ResourceStatusCreationFailed {
+			var errs *multierror.Error
+
+			for _, v := range output.Version.Errors {
+				errs = multierror.Append(errs, fmt.Errorf("%s: %s", aws.StringValue(v.Type), aws.StringValue(v.Message)))
+			}
+
+			tfresource.SetLastError(err, errs.ErrorOrNil())
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// waitDashboardUpdated waits for a new Dashboard version to return CREATION_SUCCESSFUL or UPDATE_SUCCESSFUL
+func waitDashboardUpdated(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, dashboardID string, versionNumber int64) (*quicksight.Dashboard, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{quicksight.ResourceStatusUpdateInProgress, quicksight.ResourceStatusCreationInProgress},
+		Target:  []string{quicksight.ResourceStatusUpdateSuccessful, quicksight.ResourceStatusCreationSuccessful},
+		Refresh: statusDashboard(ctx, conn, awsAccountID, dashboardID, versionNumber),
+		Timeout: dashboardUpdateTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*quicksight.Dashboard); ok {
+		if status := aws.StringValue(output.Version.Status); status == quicksight.ResourceStatusUpdateFailed || status == quicksight.ResourceStatusCreationFailed {
+			var errs *multierror.Error
+
+			for _, v := range output.Version.Errors {
+				errs = multierror.Append(errs, fmt.Errorf("%s: %s", aws.StringValue(v.Type), aws.StringValue(v.Message)))
+			}
+
+			tfresource.SetLastError(err, errs.ErrorOrNil())
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// waitTemplateCreated waits for a Template version to return CREATION_SUCCESSFUL
+func waitTemplateCreated(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, templateID string, versionNumber int64) (*quicksight.Template, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{quicksight.ResourceStatusCreationInProgress},
+		Target:  []string{quicksight.ResourceStatusCreationSuccessful},
+		Refresh: statusTemplate(ctx, conn, awsAccountID, templateID, versionNumber),
+		Timeout: templateCreateTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*quicksight.Template); ok {
+		if status := aws.StringValue(output.Version.Status); status == quicksight.ResourceStatusCreationFailed {
+			var errs *multierror.Error
+
+			for _, v := range output.Version.Errors {
+				errs = multierror.Append(errs, fmt.Errorf("%s: %s", aws.StringValue(v.Type), aws.StringValue(v.Message)))
+			}
+
+			tfresource.SetLastError(err, errs.ErrorOrNil())
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// waitTemplateUpdated waits for a new Template version to return CREATION_SUCCESSFUL or UPDATE_SUCCESSFUL
+func waitTemplateUpdated(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, templateID string, versionNumber int64) (*quicksight.Template, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{quicksight.ResourceStatusUpdateInProgress, quicksight.ResourceStatusCreationInProgress},
+		Target:  []string{quicksight.ResourceStatusUpdateSuccessful, quicksight.ResourceStatusCreationSuccessful},
+		Refresh: statusTemplate(ctx, conn, awsAccountID, templateID, versionNumber),
+		Timeout: templateUpdateTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*quicksight.Template); ok {
+		if status := aws.StringValue(output.Version.Status); status == quicksight.ResourceStatusUpdateFailed || status == quicksight.ResourceStatusCreationFailed {
+			var errs *multierror.Error
+
+			for _, v := range output.Version.Errors {
+				errs = multierror.Append(errs, fmt.Errorf("%s: %s", aws.StringValue(v.Type), aws.StringValue(v.Message)))
+			}
+
+			tfresource.SetLastError(err, errs.ErrorOrNil())
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// waitThemeCreated waits for a Theme version to return CREATION_SUCCESSFUL
+func waitThemeCreated(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, themeID string, versionNumber int64) (*quicksight.Theme, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{quicksight.ResourceStatusCreationInProgress},
+		Target:  []string{quicksight.ResourceStatusCreationSuccessful},
+		Refresh: statusTheme(ctx, conn, awsAccountID, themeID, versionNumber),
+		Timeout: themeCreateTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*quicksight.Theme); ok {
+		if status := aws.StringValue(output.Version.Status); status == quicksight.ResourceStatusCreationFailed {
+			var errs *multierror.Error
+
+			for _, v := range output.Version.Errors {
+				errs = multierror.Append(errs, fmt.Errorf("%s: %s", aws.StringValue(v.Type), aws.StringValue(v.Message)))
+			}
+
+			tfresource.SetLastError(err, errs.ErrorOrNil())
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// waitThemeUpdated waits for a new Theme version to return CREATION_SUCCESSFUL or UPDATE_SUCCESSFUL
+func waitThemeUpdated(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, themeID string, versionNumber int64) (*quicksight.Theme, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{quicksight.ResourceStatusUpdateInProgress, quicksight.ResourceStatusCreationInProgress},
+		Target:  []string{quicksight.ResourceStatusUpdateSuccessful, quicksight.ResourceStatusCreationSuccessful},
+		Refresh: statusTheme(ctx, conn, awsAccountID, themeID, versionNumber),
+		Timeout: themeUpdateTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*quicksight.Theme); ok {
+		if status := aws.StringValue(output.Version.Status); status == quicksight.ResourceStatusUpdateFailed || status == quicksight.ResourceStatusCreationFailed {
+			var errs *multierror.Error
+
+			for _, v := range output.Version.Errors {
+				errs = multierror.Append(errs, fmt.Errorf("%s: %s", aws.StringValue(v.Type), aws.StringValue(v.Message)))
+			}
+
+			tfresource.SetLastError(err, errs.ErrorOrNil())
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
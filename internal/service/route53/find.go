@@ -85,3 +85,136 @@ func FindKeySigningKeyByResourceID(conn *route53.Route53, resourceID string) (*r
 
 	return FindKeySigningKey(conn, hostedZoneID, name)
 }
+
+func FindTrafficPolicyByID(conn *route53.Route53, id string) (*route53.TrafficPolicy, error) {
+	summary, err := FindTrafficPolicySummaryByID(conn, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return FindTrafficPolicyByIDAndVersion(conn, id, aws.Int64Value(summary.LatestVersion))
+}
+
+func FindTrafficPolicyByIDAndVersion(conn *route53.Route53, id string, version int64) (*route53.TrafficPolicy, error) {
+	input := &route53.GetTrafficPolicyInput{
+		Id:      aws.String(id),
+		Version: aws.Int64(version),
+	}
+
+	output, err := conn.GetTrafficPolicy(input)
+
+	if tfawserr.ErrCodeEquals(err, route53.ErrCodeNoSuchTrafficPolicy) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.TrafficPolicy == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.TrafficPolicy, nil
+}
+
+func FindTrafficPolicySummaryByID(conn *route53.Route53, id string) (*route53.TrafficPolicySummary, error) {
+	input := &route53.ListTrafficPoliciesInput{}
+
+	for {
+		output, err := conn.ListTrafficPolicies(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil {
+			break
+		}
+
+		for _, v := range output.TrafficPolicySummaries {
+			if v != nil && aws.StringValue(v.Id) == id {
+				return v, nil
+			}
+		}
+
+		if !aws.BoolValue(output.IsTruncated) {
+			break
+		}
+
+		input.TrafficPolicyIdMarker = output.TrafficPolicyIdMarker
+	}
+
+	return nil, &resource.NotFoundError{
+		LastRequest: input,
+	}
+}
+
+func FindTrafficPolicyVersionsByID(conn *route53.Route53, id string) ([]*route53.TrafficPolicy, error) {
+	input := &route53.ListTrafficPolicyVersionsInput{
+		Id: aws.String(id),
+	}
+	var output []*route53.TrafficPolicy
+
+	for {
+		page, err := conn.ListTrafficPolicyVersions(input)
+
+		if tfawserr.ErrCodeEquals(err, route53.ErrCodeNoSuchTrafficPolicy) {
+			return nil, &resource.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if page == nil {
+			break
+		}
+
+		for _, v := range page.TrafficPolicies {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		if !aws.BoolValue(page.IsTruncated) {
+			break
+		}
+
+		input.TrafficPolicyVersionMarker = page.TrafficPolicyVersionMarker
+	}
+
+	return output, nil
+}
+
+func FindTrafficPolicyInstanceByID(conn *route53.Route53, id string) (*route53.TrafficPolicyInstance, error) {
+	input := &route53.GetTrafficPolicyInstanceInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetTrafficPolicyInstance(input)
+
+	if tfawserr.ErrCodeEquals(err, route53.ErrCodeNoSuchTrafficPolicyInstance) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.TrafficPolicyInstance == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.TrafficPolicyInstance, nil
+}
@@ -0,0 +1,167 @@
+package route53
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceRecords() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceRecordsRead,
+
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"resource_record_sets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alias": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"evaluate_target_health": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"zone_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"health_check_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"records": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"set_identifier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(route53.RRType_Values(), false),
+			},
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceRecordsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	zoneID := CleanZoneID(d.Get("zone_id").(string))
+	input := &route53.ListResourceRecordSetsInput{
+		HostedZoneId: aws.String(zoneID),
+	}
+
+	var nameRegex *regexp.Regexp
+
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+
+	recordType := d.Get("type").(string)
+	var tfList []interface{}
+
+	err := conn.ListResourceRecordSetsPages(input, func(page *route53.ListResourceRecordSetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ResourceRecordSets {
+			if v == nil {
+				continue
+			}
+
+			if recordType != "" && aws.StringValue(v.Type) != recordType {
+				continue
+			}
+
+			name := TrimTrailingPeriod(CleanRecordName(aws.StringValue(v.Name)))
+
+			if nameRegex != nil && !nameRegex.MatchString(name) {
+				continue
+			}
+
+			tfList = append(tfList, flattenResourceRecordSet(v, name))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error listing Route 53 Hosted Zone (%s) records: %w", zoneID, err)
+	}
+
+	d.SetId(zoneID)
+
+	if err := d.Set("resource_record_sets", tfList); err != nil {
+		return fmt.Errorf("error setting resource_record_sets: %w", err)
+	}
+
+	return nil
+}
+
+func flattenResourceRecordSet(apiObject *route53.ResourceRecordSet, name string) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"health_check_id": aws.StringValue(apiObject.HealthCheckId),
+		"name":            name,
+		"records":         FlattenResourceRecords(apiObject.ResourceRecords, aws.StringValue(apiObject.Type)),
+		"set_identifier":  aws.StringValue(apiObject.SetIdentifier),
+		"ttl":             aws.Int64Value(apiObject.TTL),
+		"type":            aws.StringValue(apiObject.Type),
+	}
+
+	if v := apiObject.AliasTarget; v != nil {
+		tfMap["alias"] = []interface{}{
+			map[string]interface{}{
+				"evaluate_target_health": aws.BoolValue(v.EvaluateTargetHealth),
+				"name":                   NormalizeAliasName(aws.StringValue(v.DNSName)),
+				"zone_id":                aws.StringValue(v.HostedZoneId),
+			},
+		}
+	}
+
+	return tfMap
+}
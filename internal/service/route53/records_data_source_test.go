@@ -0,0 +1,81 @@
+package route53_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccRoute53RecordsDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_route53_records.test"
+	zoneName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, route53.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRoute53ZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsDataSourceConfig(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.#", "4"),
+					resource.TestCheckResourceAttr("data.aws_route53_records.type", "resource_record_sets.#", "1"),
+					resource.TestCheckResourceAttr("data.aws_route53_records.type", "resource_record_sets.0.name", fmt.Sprintf("www.%s", zoneName)),
+					resource.TestCheckResourceAttr("data.aws_route53_records.type", "resource_record_sets.0.type", "A"),
+					resource.TestCheckResourceAttr("data.aws_route53_records.type", "resource_record_sets.0.ttl", "300"),
+					resource.TestCheckResourceAttr("data.aws_route53_records.type", "resource_record_sets.0.records.#", "1"),
+					resource.TestCheckResourceAttr("data.aws_route53_records.type", "resource_record_sets.0.records.0", "10.0.0.1"),
+					resource.TestCheckResourceAttr("data.aws_route53_records.name_regex", "resource_record_sets.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRecordsDataSourceConfig(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_record" "www" {
+  zone_id = aws_route53_zone.test.zone_id
+  name    = "www"
+  type    = "A"
+  ttl     = 300
+  records = ["10.0.0.1"]
+}
+
+resource "aws_route53_record" "txt" {
+  zone_id = aws_route53_zone.test.zone_id
+  name    = "www"
+  type    = "TXT"
+  ttl     = 300
+  records = ["test"]
+}
+
+data "aws_route53_records" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  depends_on = [aws_route53_record.www, aws_route53_record.txt]
+}
+
+data "aws_route53_records" "type" {
+  zone_id = aws_route53_zone.test.zone_id
+  type    = "A"
+
+  depends_on = [aws_route53_record.www, aws_route53_record.txt]
+}
+
+data "aws_route53_records" "name_regex" {
+  zone_id    = aws_route53_zone.test.zone_id
+  name_regex = "^www\\."
+
+  depends_on = [aws_route53_record.www, aws_route53_record.txt]
+}
+`, zoneName)
+}
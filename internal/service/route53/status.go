@@ -4,6 +4,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func statusChangeInfo(conn *route53.Route53, changeID string) resource.StateRefreshFunc {
@@ -57,3 +58,19 @@ func statusKeySigningKey(conn *route53.Route53, hostedZoneID string, name string
 		return keySigningKey, aws.StringValue(keySigningKey.Status), nil
 	}
 }
+
+func statusTrafficPolicyInstanceState(conn *route53.Route53, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindTrafficPolicyInstanceByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
@@ -0,0 +1,185 @@
+package route53
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceTrafficPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTrafficPolicyCreate,
+		Read:   resourceTrafficPolicyRead,
+		Update: resourceTrafficPolicyUpdate,
+		Delete: resourceTrafficPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"comment": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"document": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(0, 102400),
+					validation.StringIsJSON,
+				),
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceTrafficPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	name := d.Get("name").(string)
+	input := &route53.CreateTrafficPolicyInput{
+		Document: aws.String(d.Get("document").(string)),
+		Name:     aws.String(name),
+	}
+
+	if v, ok := d.GetOk("comment"); ok {
+		input.Comment = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Route 53 Traffic Policy: %s", input)
+	output, err := conn.CreateTrafficPolicy(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Route 53 Traffic Policy (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.TrafficPolicy.Id))
+
+	return resourceTrafficPolicyRead(d, meta)
+}
+
+func resourceTrafficPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	trafficPolicy, err := FindTrafficPolicyByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route 53 Traffic Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Route 53 Traffic Policy (%s): %w", d.Id(), err)
+	}
+
+	d.Set("comment", trafficPolicy.Comment)
+	d.Set("document", trafficPolicy.Document)
+	d.Set("name", trafficPolicy.Name)
+	d.Set("type", trafficPolicy.Type)
+	d.Set("version", trafficPolicy.Version)
+
+	return nil
+}
+
+func resourceTrafficPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	// A new document creates a new version of the traffic policy; the comment is per-version.
+	if d.HasChange("document") {
+		input := &route53.CreateTrafficPolicyVersionInput{
+			Document: aws.String(d.Get("document").(string)),
+			Id:       aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("comment"); ok {
+			input.Comment = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Creating Route 53 Traffic Policy version: %s", input)
+		_, err := conn.CreateTrafficPolicyVersion(input)
+
+		if err != nil {
+			return fmt.Errorf("error creating Route 53 Traffic Policy (%s) version: %w", d.Id(), err)
+		}
+	} else if d.HasChange("comment") {
+		input := &route53.UpdateTrafficPolicyCommentInput{
+			Comment: aws.String(d.Get("comment").(string)),
+			Id:      aws.String(d.Id()),
+			Version: aws.Int64(int64(d.Get("version").(int))),
+		}
+
+		log.Printf("[DEBUG] Updating Route 53 Traffic Policy comment: %s", input)
+		_, err := conn.UpdateTrafficPolicyComment(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Route 53 Traffic Policy (%s) comment: %w", d.Id(), err)
+		}
+	}
+
+	return resourceTrafficPolicyRead(d, meta)
+}
+
+func resourceTrafficPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	trafficPolicies, err := FindTrafficPolicyVersionsByID(conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Route 53 Traffic Policy (%s) versions: %w", d.Id(), err)
+	}
+
+	for _, trafficPolicy := range trafficPolicies {
+		version := aws.Int64Value(trafficPolicy.Version)
+
+		log.Printf("[DEBUG] Deleting Route 53 Traffic Policy: %s, version %d", d.Id(), version)
+		_, err := conn.DeleteTrafficPolicy(&route53.DeleteTrafficPolicyInput{
+			Id:      aws.String(d.Id()),
+			Version: aws.Int64(version),
+		})
+
+		if tfawserr.ErrCodeEquals(err, route53.ErrCodeNoSuchTrafficPolicy) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error deleting Route 53 Traffic Policy (%s) version (%d): %w", d.Id(), version, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,178 @@
+package route53_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/route53"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfroute53 "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccRoute53TrafficPolicy_basic(t *testing.T) {
+	var v route53.TrafficPolicy
+	resourceName := "aws_route53_traffic_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, route53.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTrafficPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrafficPolicyConfig(rName, "comment1", "10.0.0.1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTrafficPolicyExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "comment1"),
+					resource.TestCheckResourceAttrSet(resourceName, "document"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "type", "A"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRoute53TrafficPolicy_disappears(t *testing.T) {
+	var v route53.TrafficPolicy
+	resourceName := "aws_route53_traffic_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, route53.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTrafficPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrafficPolicyConfig(rName, "comment1", "10.0.0.1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrafficPolicyExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfroute53.ResourceTrafficPolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRoute53TrafficPolicy_update(t *testing.T) {
+	var v route53.TrafficPolicy
+	resourceName := "aws_route53_traffic_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, route53.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTrafficPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrafficPolicyConfig(rName, "comment1", "10.0.0.1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrafficPolicyExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "comment1"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				Config: testAccTrafficPolicyConfig(rName, "comment2", "10.0.0.1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrafficPolicyExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "comment2"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				Config: testAccTrafficPolicyConfig(rName, "comment2", "10.0.0.2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrafficPolicyExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "comment2"),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTrafficPolicyExists(n string, v *route53.TrafficPolicy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Route 53 Traffic Policy ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn
+
+		output, err := tfroute53.FindTrafficPolicyByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckTrafficPolicyDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_route53_traffic_policy" {
+			continue
+		}
+
+		_, err := tfroute53.FindTrafficPolicyByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Route 53 Traffic Policy %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccTrafficPolicyConfig(rName, comment, value string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_traffic_policy" "test" {
+  name    = %[1]q
+  comment = %[2]q
+
+  document = jsonencode({
+    AWSPolicyFormatVersion = "2015-10-01"
+    RecordType             = "A"
+    Endpoints = {
+      endpoint-start-NkPh = {
+        Type  = "value"
+        Value = %[3]q
+      }
+    }
+    StartEndpoint = "endpoint-start-NkPh"
+  })
+}
+`, rName, comment, value)
+}
@@ -1,8 +1,10 @@
 package sfn
 
 import (
+	"encoding/json"
 	"fmt"
 	"regexp"
+	"sort"
 )
 
 func validStateMachineName(v interface{}, k string) (ws []string, errors []error) {
@@ -17,3 +19,171 @@ func validStateMachineName(v interface{}, k string) (ws []string, errors []error
 	}
 	return
 }
+
+// validStateMachineDefinition performs a structural check of an Amazon States Language
+// definition: every state machine (including Parallel branches and Map iterators) must have
+// a StartAt naming one of its States, every Next, Default and Catch target must name a state
+// in the same scope, and every non-terminal state must either transition or set End.
+func validStateMachineDefinition(v interface{}, k string) (ws []string, errors []error) {
+	var definition map[string]interface{}
+
+	if err := json.Unmarshal([]byte(v.(string)), &definition); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid JSON: %w", k, err))
+		return
+	}
+
+	for _, err := range validateStateMachineStates(definition, "") {
+		errors = append(errors, fmt.Errorf("%q is not a valid Amazon States Language definition: %w", k, err))
+	}
+
+	return
+}
+
+func validateStateMachineStates(machine map[string]interface{}, path string) []error {
+	var errs []error
+
+	states, ok := machine["States"].(map[string]interface{})
+
+	if !ok || len(states) == 0 {
+		return append(errs, fmt.Errorf("%sStates must be a non-empty object", path))
+	}
+
+	startAt, ok := machine["StartAt"].(string)
+
+	if !ok || startAt == "" {
+		errs = append(errs, fmt.Errorf("%sStartAt must be set", path))
+	} else if _, ok := states[startAt]; !ok {
+		errs = append(errs, fmt.Errorf("%sStartAt state (%s) does not exist", path, startAt))
+	}
+
+	// Sort the state names so that errors are reported in a stable order.
+	names := make([]string, 0, len(states))
+	for name := range states {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		statePath := fmt.Sprintf("%sStates.%s: ", path, name)
+
+		if len(name) > 80 {
+			errs = append(errs, fmt.Errorf("%sstate name cannot be longer than 80 characters", statePath))
+		}
+
+		state, ok := states[name].(map[string]interface{})
+
+		if !ok {
+			errs = append(errs, fmt.Errorf("%sstate must be an object", statePath))
+			continue
+		}
+
+		checkTarget := func(field string, v interface{}) {
+			target, ok := v.(string)
+
+			if !ok || target == "" {
+				errs = append(errs, fmt.Errorf("%s%s must be a state name", statePath, field))
+				return
+			}
+
+			if _, ok := states[target]; !ok {
+				errs = append(errs, fmt.Errorf("%s%s state (%s) does not exist", statePath, field, target))
+			}
+		}
+
+		next, hasNext := state["Next"]
+		end, _ := state["End"].(bool)
+
+		switch stateType, _ := state["Type"].(string); stateType {
+		case "Choice":
+			choices, ok := state["Choices"].([]interface{})
+
+			if !ok || len(choices) == 0 {
+				errs = append(errs, fmt.Errorf("%sChoices must be a non-empty array", statePath))
+			}
+
+			for i, v := range choices {
+				choice, ok := v.(map[string]interface{})
+
+				if !ok {
+					errs = append(errs, fmt.Errorf("%sChoices[%d] must be an object", statePath, i))
+					continue
+				}
+
+				checkTarget(fmt.Sprintf("Choices[%d].Next", i), choice["Next"])
+			}
+
+			if v, ok := state["Default"]; ok {
+				checkTarget("Default", v)
+			}
+
+			if hasNext || end {
+				errs = append(errs, fmt.Errorf("%sChoice state cannot set Next or End", statePath))
+			}
+		case "Fail", "Succeed":
+			if hasNext || end {
+				errs = append(errs, fmt.Errorf("%s%s state cannot set Next or End", statePath, stateType))
+			}
+		case "Map", "Parallel", "Pass", "Task", "Wait":
+			switch {
+			case hasNext && end:
+				errs = append(errs, fmt.Errorf("%sonly one of Next or End can be set", statePath))
+			case hasNext:
+				checkTarget("Next", next)
+			case !end:
+				errs = append(errs, fmt.Errorf("%sone of Next or End must be set", statePath))
+			}
+
+			if stateType == "Parallel" {
+				branches, ok := state["Branches"].([]interface{})
+
+				if !ok || len(branches) == 0 {
+					errs = append(errs, fmt.Errorf("%sBranches must be a non-empty array", statePath))
+				}
+
+				for i, v := range branches {
+					branch, ok := v.(map[string]interface{})
+
+					if !ok {
+						errs = append(errs, fmt.Errorf("%sBranches[%d] must be an object", statePath, i))
+						continue
+					}
+
+					errs = append(errs, validateStateMachineStates(branch, fmt.Sprintf("%sStates.%s.Branches[%d].", path, name, i))...)
+				}
+			}
+
+			if stateType == "Map" {
+				field := "ItemProcessor"
+				processor, ok := state[field].(map[string]interface{})
+
+				if !ok {
+					field = "Iterator"
+					processor, ok = state[field].(map[string]interface{})
+				}
+
+				if !ok {
+					errs = append(errs, fmt.Errorf("%sone of ItemProcessor or Iterator must be set", statePath))
+				} else {
+					errs = append(errs, validateStateMachineStates(processor, fmt.Sprintf("%sStates.%s.%s.", path, name, field))...)
+				}
+			}
+		default:
+			errs = append(errs, fmt.Errorf("%sunsupported Type (%s)", statePath, stateType))
+		}
+
+		if catchers, ok := state["Catch"].([]interface{}); ok {
+			for i, v := range catchers {
+				catcher, ok := v.(map[string]interface{})
+
+				if !ok {
+					errs = append(errs, fmt.Errorf("%sCatch[%d] must be an object", statePath, i))
+					continue
+				}
+
+				checkTarget(fmt.Sprintf("Catch[%d].Next", i), catcher["Next"])
+			}
+		}
+	}
+
+	return errs
+}
@@ -49,3 +49,48 @@ func TestValidStateMachineName(t *testing.T) {
 		}
 	}
 }
+
+func TestValidStateMachineDefinition(t *testing.T) {
+	validDefinitions := []string{
+		`{"StartAt": "A", "States": {"A": {"Type": "Pass", "End": true}}}`,
+		`{"StartAt": "A", "States": {"A": {"Type": "Task", "Resource": "arn", "Next": "B"}, "B": {"Type": "Succeed"}}}`,
+		`{"StartAt": "A", "States": {"A": {"Type": "Choice", "Choices": [{"Variable": "$.x", "IsPresent": true, "Next": "B"}], "Default": "C"}, "B": {"Type": "Succeed"}, "C": {"Type": "Fail"}}}`,
+		`{"StartAt": "A", "States": {"A": {"Type": "Task", "Resource": "arn", "Catch": [{"ErrorEquals": ["States.ALL"], "Next": "B"}], "End": true}, "B": {"Type": "Fail"}}}`,
+		`{"StartAt": "A", "States": {"A": {"Type": "Parallel", "Branches": [{"StartAt": "X", "States": {"X": {"Type": "Pass", "End": true}}}], "End": true}}}`,
+		`{"StartAt": "A", "States": {"A": {"Type": "Map", "Iterator": {"StartAt": "X", "States": {"X": {"Type": "Pass", "End": true}}}, "End": true}}}`,
+		`{"StartAt": "A", "States": {"A": {"Type": "Map", "ItemProcessor": {"StartAt": "X", "States": {"X": {"Type": "Pass", "End": true}}}, "End": true}}}`,
+	}
+
+	invalidDefinitions := []string{
+		`not json`,
+		`{}`,
+		`{"StartAt": "A", "States": {}}`,
+		`{"States": {"A": {"Type": "Pass", "End": true}}}`,
+		`{"StartAt": "B", "States": {"A": {"Type": "Pass", "End": true}}}`,
+		`{"StartAt": "A", "States": {"A": {"Type": "Pass"}}}`,
+		`{"StartAt": "A", "States": {"A": {"Type": "Pass", "Next": "B", "End": true}, "B": {"Type": "Succeed"}}}`,
+		`{"StartAt": "A", "States": {"A": {"Type": "Pass", "Next": "B"}}}`,
+		`{"StartAt": "A", "States": {"A": {"Type": "Unknown", "End": true}}}`,
+		`{"StartAt": "A", "States": {"A": {"Type": "Succeed", "End": true}}}`,
+		`{"StartAt": "A", "States": {"A": {"Type": "Choice", "Choices": []}}}`,
+		`{"StartAt": "A", "States": {"A": {"Type": "Choice", "Choices": [{"Variable": "$.x", "IsPresent": true, "Next": "B"}]}}}`,
+		`{"StartAt": "A", "States": {"A": {"Type": "Task", "Resource": "arn", "Catch": [{"ErrorEquals": ["States.ALL"], "Next": "B"}], "End": true}}}`,
+		`{"StartAt": "A", "States": {"A": {"Type": "Parallel", "Branches": [{"StartAt": "X", "States": {"X": {"Type": "Pass", "Next": "A"}}}], "End": true}}}`,
+		`{"StartAt": "A", "States": {"A": {"Type": "Map", "End": true}}}`,
+		`{"StartAt": "` + strings.Repeat("W", 81) + `", "States": {"` + strings.Repeat("W", 81) + `": {"Type": "Pass", "End": true}}}`,
+	}
+
+	for _, v := range validDefinitions {
+		_, errors := validStateMachineDefinition(v, "definition")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Step Function State Machine definition: %v", v, errors)
+		}
+	}
+
+	for _, v := range invalidDefinitions {
+		_, errors := validStateMachineDefinition(v, "definition")
+		if len(errors) == 0 {
+			t.Fatalf("%q should not be a valid Step Function State Machine definition", v)
+		}
+	}
+}
@@ -1,4 +1,5 @@
 //go:generate go run ../../generate/tags/main.go -ListTags -ServiceTagsSlice -UpdateTags
+//go:generate go run -tags generate ../../generate/listdatasource/main.go -Name=Topics -ListOp=ListTopics -ListOutElem=Topics -IDElem=TopicArn "-HumanFriendly=SNS Topics"
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package sns
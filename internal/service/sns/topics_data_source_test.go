@@ -0,0 +1,61 @@
+package sns_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/sns"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccSNSTopicsDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, sns.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTopicsDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_sns_topics.name_regex", "arns.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_sns_topics.name_regex", "names.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_sns_topics.tags", "arns.#", "1"),
+					resource.TestCheckResourceAttrPair("data.aws_sns_topics.tags", "arns.0", "aws_sns_topic.test.0", "arn"),
+					resource.TestCheckResourceAttrPair("data.aws_sns_topics.tags", "names.0", "aws_sns_topic.test.0", "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTopicsDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  count = 2
+
+  name = "%[1]s-${count.index}"
+
+  tags = {
+    Name = "%[1]s-${count.index}"
+  }
+}
+
+data "aws_sns_topics" "name_regex" {
+  name_regex = "^%[1]s-"
+
+  depends_on = [aws_sns_topic.test]
+}
+
+data "aws_sns_topics" "tags" {
+  tags = {
+    Name = "%[1]s-0"
+  }
+
+  depends_on = [aws_sns_topic.test]
+}
+`, rName)
+}
@@ -1,4 +1,5 @@
 //go:generate go run ../../generate/tags/main.go -ListTags -ListTagsOp=ListQueueTags -ListTagsInIDElem=QueueUrl -ServiceTagsMap -TagOp=TagQueue -TagInIDElem=QueueUrl -UntagOp=UntagQueue -UpdateTags
+//go:generate go run -tags generate ../../generate/listdatasource/main.go -Name=Queues -ListOp=ListQueues -ListOutElem=QueueUrls -IDAttribName=urls "-HumanFriendly=SQS Queues"
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package sqs
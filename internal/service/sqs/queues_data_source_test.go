@@ -0,0 +1,61 @@
+package sqs_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/sqs"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccSQSQueuesDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, sqs.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueuesDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_sqs_queues.name_regex", "urls.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_sqs_queues.name_regex", "names.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_sqs_queues.tags", "urls.#", "1"),
+					resource.TestCheckResourceAttrPair("data.aws_sqs_queues.tags", "urls.0", "aws_sqs_queue.test.0", "url"),
+					resource.TestCheckResourceAttrPair("data.aws_sqs_queues.tags", "names.0", "aws_sqs_queue.test.0", "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccQueuesDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  count = 2
+
+  name = "%[1]s-${count.index}"
+
+  tags = {
+    Name = "%[1]s-${count.index}"
+  }
+}
+
+data "aws_sqs_queues" "name_regex" {
+  name_regex = "^%[1]s-"
+
+  depends_on = [aws_sqs_queue.test]
+}
+
+data "aws_sqs_queues" "tags" {
+  tags = {
+    Name = "%[1]s-0"
+  }
+
+  depends_on = [aws_sqs_queue.test]
+}
+`, rName)
+}
@@ -0,0 +1,342 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
+)
+
+const (
+	// DefaultSweeperConcurrency is the default number of sweepers run concurrently in a region.
+	DefaultSweeperConcurrency = 10
+
+	// sweeperDependencyViolationRetries is the maximum number of times sweepers failing with
+	// DependencyViolation errors are run again after all other sweepers have run.
+	sweeperDependencyViolationRetries = 3
+)
+
+// sweepers are the sweepers registered with AddTestSweepers.
+var sweepers = make(map[string]*resource.Sweeper)
+
+// TestMain runs the sweepers for the regions given by the -sweep flag or, without it, the tests.
+// It replaces the Terraform Plugin SDK's resource.TestMain, which runs sweepers one at a time.
+// Sweepers are run in dependency order: the sweepers in each level of the dependency graph
+// run concurrently, up to the TF_AWS_SWEEP_CONCURRENCY limit, once all of their dependencies have run.
+// The -sweep, -sweep-run and -sweep-allow-failures flags of the Terraform Plugin SDK are supported.
+func TestMain(m *testing.M) {
+	flag.Parse()
+
+	regions := flagValue("sweep")
+
+	if regions == "" {
+		os.Exit(m.Run())
+	}
+
+	allowFailures, _ := strconv.ParseBool(flagValue("sweep-allow-failures"))
+
+	if err := runSweepers(strings.Split(regions, ","), filterSweepers(flagValue("sweep-run"), sweepers), allowFailures); err != nil {
+		log.Printf("[ERROR] %s", err)
+		os.Exit(1)
+	}
+}
+
+func flagValue(name string) string {
+	if f := flag.Lookup(name); f != nil {
+		return f.Value.String()
+	}
+
+	return ""
+}
+
+// sweeperConcurrency returns the number of sweepers to run concurrently.
+// Sweepers run one at a time in dry-run mode or with resource selection, as mutating API calls
+// are only allowed while SweepOrchestratorWithContext deletes selected resources.
+func sweeperConcurrency() (int, error) {
+	if restricted() {
+		return 1, nil
+	}
+
+	v := os.Getenv(conns.EnvVarSweepConcurrency)
+
+	if v == "" {
+		return DefaultSweeperConcurrency, nil
+	}
+
+	n, err := strconv.Atoi(v)
+
+	if err != nil || n < 1 {
+		return 0, fmt.Errorf("environment variable %s: expected positive integer, got %q", conns.EnvVarSweepConcurrency, v)
+	}
+
+	return n, nil
+}
+
+// filterSweepers returns the sweepers whose names contain any of the comma-separated filters, with all of
+// their dependencies, or all sweepers if there is no filter.
+func filterSweepers(filter string, source map[string]*resource.Sweeper) map[string]*resource.Sweeper {
+	if filter == "" {
+		return source
+	}
+
+	result := make(map[string]*resource.Sweeper)
+
+	var add func(string)
+	add = func(name string) {
+		s, ok := source[name]
+
+		if !ok {
+			log.Printf("[WARN] Sweeper has dependency (%s), but that sweeper was not found", name)
+			return
+		}
+
+		if _, ok := result[name]; ok {
+			return
+		}
+
+		result[name] = s
+
+		for _, dependency := range s.Dependencies {
+			add(dependency)
+		}
+	}
+
+	for name := range source {
+		for _, f := range strings.Split(strings.ToLower(filter), ",") {
+			if strings.Contains(strings.ToLower(name), f) {
+				add(name)
+			}
+		}
+	}
+
+	return result
+}
+
+// sweeperLevels orders sweepers into levels such that each sweeper's dependencies are in earlier levels.
+// Sweepers within a level do not depend on each other and can run concurrently.
+func sweeperLevels(sweepers map[string]*resource.Sweeper) ([][]string, error) {
+	levels := make(map[string]int)
+	visiting := make(map[string]bool)
+
+	var visit func(string) (int, error)
+	visit = func(name string) (int, error) {
+		if level, ok := levels[name]; ok {
+			return level, nil
+		}
+
+		if visiting[name] {
+			return 0, fmt.Errorf("sweeper (%s) has a circular dependency", name)
+		}
+
+		visiting[name] = true
+		level := 0
+
+		for _, dependency := range sweepers[name].Dependencies {
+			if _, ok := sweepers[dependency]; !ok {
+				log.Printf("[WARN] Sweeper (%s) has dependency (%s), but that sweeper was not found", name, dependency)
+				continue
+			}
+
+			l, err := visit(dependency)
+
+			if err != nil {
+				return 0, err
+			}
+
+			if l+1 > level {
+				level = l + 1
+			}
+		}
+
+		visiting[name] = false
+		levels[name] = level
+
+		return level, nil
+	}
+
+	names := make([]string, 0, len(sweepers))
+
+	for name := range sweepers {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	var result [][]string
+
+	for _, name := range names {
+		level, err := visit(name)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for len(result) <= level {
+			result = append(result, nil)
+		}
+
+		result[level] = append(result[level], name)
+	}
+
+	return result, nil
+}
+
+func runSweepers(regions []string, sweepers map[string]*resource.Sweeper, allowFailures bool) error {
+	levels, err := sweeperLevels(sweepers)
+
+	if err != nil {
+		return err
+	}
+
+	concurrency, err := sweeperConcurrency()
+
+	if err != nil {
+		return err
+	}
+
+	var failed bool
+
+	for _, region := range regions {
+		region = strings.TrimSpace(region)
+
+		start := time.Now()
+		log.Printf("[DEBUG] Running Sweepers for region (%s)", region)
+
+		results, err := runSweepersWithRegion(region, levels, sweepers, concurrency, allowFailures)
+
+		log.Printf("[DEBUG] Completed Sweepers for region (%s) in %s", region, time.Since(start))
+
+		names := make([]string, 0, len(results))
+
+		for name := range results {
+			names = append(names, name)
+		}
+
+		sort.Strings(names)
+
+		for _, name := range names {
+			if results[name] != nil {
+				failed = true
+				log.Printf("[ERROR] Sweeper (%s) in region (%s) failed: %s", name, region, results[name])
+			} else {
+				log.Printf("[INFO] Sweeper (%s) in region (%s) ran successfully", name, region)
+			}
+		}
+
+		if err != nil {
+			return fmt.Errorf("sweepers for region (%s) failed: %w", region, err)
+		}
+	}
+
+	if failed {
+		return errors.New("at least one sweeper failed")
+	}
+
+	return nil
+}
+
+// runSweepersWithRegion runs the sweepers level by level.
+// Sweepers failing with DependencyViolation errors, typically because resources of another type that
+// depend on their resources were still being deleted, are run again once all other sweepers have run.
+// Without allowFailures, any other failure stops the run after the failing sweeper's level.
+func runSweepersWithRegion(region string, levels [][]string, sweepers map[string]*resource.Sweeper, concurrency int, allowFailures bool) (map[string]error, error) {
+	results := make(map[string]error)
+
+	for i, level := range levels {
+		log.Printf("[DEBUG] Running Sweepers level %d in region (%s): %s", i, region, strings.Join(level, ", "))
+
+		runSweeperLevel(region, level, sweepers, concurrency, results)
+
+		if allowFailures {
+			continue
+		}
+
+		for _, name := range level {
+			if err := results[name]; err != nil && !isDependencyViolation(err) {
+				return results, fmt.Errorf("sweeper (%s) failed: %w", name, err)
+			}
+		}
+	}
+
+	for retry := 1; retry <= sweeperDependencyViolationRetries; retry++ {
+		var violations int
+
+		for _, level := range levels {
+			var names []string
+
+			for _, name := range level {
+				if isDependencyViolation(results[name]) {
+					names = append(names, name)
+				}
+			}
+
+			if len(names) == 0 {
+				continue
+			}
+
+			violations += len(names)
+
+			log.Printf("[INFO] Running Sweepers again after DependencyViolation errors in region (%s): %s", region, strings.Join(names, ", "))
+
+			runSweeperLevel(region, names, sweepers, concurrency, results)
+		}
+
+		if violations == 0 {
+			break
+		}
+	}
+
+	return results, nil
+}
+
+func runSweeperLevel(region string, names []string, sweepers map[string]*resource.Sweeper, concurrency int, results map[string]error) {
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	semaphore := make(tfsync.Semaphore, concurrency)
+
+	for _, name := range names {
+		name := name
+		s := sweepers[name]
+
+		semaphore.Wait()
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			defer semaphore.Notify()
+
+			log.Printf("[DEBUG] Running Sweeper (%s) in region (%s)", name, region)
+
+			start := time.Now()
+			err := s.F(region)
+
+			log.Printf("[DEBUG] Completed Sweeper (%s) in region (%s) in %s", name, region, time.Since(start))
+
+			mutex.Lock()
+			results[name] = err
+			mutex.Unlock()
+		}()
+	}
+
+	wg.Wait()
+}
+
+// isDependencyViolation returns whether an error is, or contains, an API DependencyViolation error.
+// Sweepers and DeleteResource often format errors without wrapping them, so the message is checked.
+func isDependencyViolation(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "DependencyViolation")
+}
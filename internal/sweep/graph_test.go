@@ -0,0 +1,155 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestSweeperLevels(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Sweepers      map[string]*resource.Sweeper
+		Expected      [][]string
+		ExpectedError bool
+	}{
+		{
+			Name: "no dependencies",
+			Sweepers: map[string]*resource.Sweeper{
+				"aws_b": {Name: "aws_b"},
+				"aws_a": {Name: "aws_a"},
+			},
+			Expected: [][]string{{"aws_a", "aws_b"}},
+		},
+		{
+			Name: "dependencies",
+			Sweepers: map[string]*resource.Sweeper{
+				"aws_vpc":               {Name: "aws_vpc", Dependencies: []string{"aws_subnet", "aws_internet_gateway"}},
+				"aws_subnet":            {Name: "aws_subnet", Dependencies: []string{"aws_network_interface"}},
+				"aws_internet_gateway":  {Name: "aws_internet_gateway"},
+				"aws_network_interface": {Name: "aws_network_interface"},
+			},
+			Expected: [][]string{
+				{"aws_internet_gateway", "aws_network_interface"},
+				{"aws_subnet"},
+				{"aws_vpc"},
+			},
+		},
+		{
+			Name: "missing dependency",
+			Sweepers: map[string]*resource.Sweeper{
+				"aws_vpc": {Name: "aws_vpc", Dependencies: []string{"aws_subnet"}},
+			},
+			Expected: [][]string{{"aws_vpc"}},
+		},
+		{
+			Name: "circular dependency",
+			Sweepers: map[string]*resource.Sweeper{
+				"aws_a": {Name: "aws_a", Dependencies: []string{"aws_b"}},
+				"aws_b": {Name: "aws_b", Dependencies: []string{"aws_c"}},
+				"aws_c": {Name: "aws_c", Dependencies: []string{"aws_a"}},
+			},
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := sweeperLevels(testCase.Sweepers)
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got: %v", got)
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestFilterSweepers(t *testing.T) {
+	source := map[string]*resource.Sweeper{
+		"aws_vpc":               {Name: "aws_vpc", Dependencies: []string{"aws_subnet"}},
+		"aws_subnet":            {Name: "aws_subnet", Dependencies: []string{"aws_network_interface"}},
+		"aws_network_interface": {Name: "aws_network_interface"},
+		"aws_instance":          {Name: "aws_instance"},
+	}
+
+	got := filterSweepers("AWS_VPC", source)
+
+	for _, name := range []string{"aws_vpc", "aws_subnet", "aws_network_interface"} {
+		if _, ok := got[name]; !ok {
+			t.Errorf("expected sweeper %s", name)
+		}
+	}
+
+	if _, ok := got["aws_instance"]; ok {
+		t.Errorf("unexpected sweeper aws_instance")
+	}
+
+	if got := filterSweepers("", source); len(got) != len(source) {
+		t.Errorf("got %d sweepers, expected %d", len(got), len(source))
+	}
+}
+
+func TestRunSweepersWithRegionDependencyViolation(t *testing.T) {
+	var order []string
+	vpcAttempts := 0
+
+	sweepers := map[string]*resource.Sweeper{
+		"aws_vpc": {
+			Name: "aws_vpc",
+			F: func(region string) error {
+				order = append(order, "aws_vpc")
+				vpcAttempts++
+
+				if vpcAttempts == 1 {
+					return fmt.Errorf("error sweeping VPCs: %w", errors.New("DependencyViolation: The vpc has dependencies and cannot be deleted."))
+				}
+
+				return nil
+			},
+			Dependencies: []string{"aws_subnet"},
+		},
+		"aws_subnet": {
+			Name: "aws_subnet",
+			F: func(region string) error {
+				order = append(order, "aws_subnet")
+				return nil
+			},
+		},
+	}
+
+	levels, err := sweeperLevels(sweepers)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	results, err := runSweepersWithRegion("us-west-2", levels, sweepers, 1, false)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := results["aws_vpc"]; err != nil {
+		t.Errorf("unexpected aws_vpc error: %s", err)
+	}
+
+	if expected := []string{"aws_subnet", "aws_vpc", "aws_vpc"}; !reflect.DeepEqual(order, expected) {
+		t.Errorf("got order %v, expected %v", order, expected)
+	}
+}
@@ -0,0 +1,159 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"reflect"
+	"runtime"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// Report is the machine-readable summary of a sweeper run,
+// written to the file named by the TF_AWS_SWEEP_REPORT environment variable.
+type Report struct {
+	DryRun     bool                                 `json:"dry_run"`
+	NamePrefix string                               `json:"name_prefix,omitempty"`
+	Tags       map[string]string                    `json:"tags,omitempty"`
+	Regions    map[string]map[string]*SweeperReport `json:"regions"`
+}
+
+// SweeperReport counts the resources handled by a sweeper in a region.
+// In dry-run mode, Deleted counts the resources that would have been deleted.
+// Attempts is greater than 1 if the sweeper was run again after DependencyViolation errors,
+// in which case Failed and Error are those of the last attempt.
+type SweeperReport struct {
+	Attempts int    `json:"attempts"`
+	Found    int    `json:"found"`
+	Excluded int    `json:"excluded"`
+	Deleted  int    `json:"deleted"`
+	Skipped  int    `json:"skipped"`
+	Failed   int    `json:"failed"`
+	Error    string `json:"error,omitempty"`
+}
+
+var (
+	reportMutex sync.Mutex
+	report      = &Report{
+		Regions: make(map[string]map[string]*SweeperReport),
+	}
+
+	// runningSweepers are the reports of the running sweepers, by sweeper name.
+	runningSweepers = make(map[string]*SweeperReport)
+
+	// sweeperFuncs are the names of the registered sweepers, by the entry address of their functions.
+	sweeperFuncs = make(map[uintptr]string)
+)
+
+// AddTestSweepers registers a sweeper, recording the resources it handles in the sweeper run report.
+// Sweepers are run by TestMain.
+func AddTestSweepers(name string, s *resource.Sweeper) {
+	if _, ok := sweepers[name]; ok {
+		log.Fatalf("[ERR] Error adding (%s) to sweepers: sweeper already exists", name)
+	}
+
+	f := s.F
+	sweeperFuncs[reflect.ValueOf(f).Pointer()] = name
+
+	s.F = func(region string) error {
+		reportMutex.Lock()
+		if _, ok := report.Regions[region]; !ok {
+			report.Regions[region] = make(map[string]*SweeperReport)
+		}
+		r, ok := report.Regions[region][name]
+		if !ok {
+			r = &SweeperReport{}
+			report.Regions[region][name] = r
+		}
+		r.Attempts++
+		r.Failed = 0
+		r.Error = ""
+		runningSweepers[name] = r
+		reportMutex.Unlock()
+
+		err := f(region)
+
+		reportMutex.Lock()
+		if err != nil {
+			r.Error = err.Error()
+		}
+		delete(runningSweepers, name)
+		log.Printf("[INFO] Sweeper (%s) in region (%s): found %d, excluded %d, deleted %d, skipped %d, failed %d", name, region, r.Found, r.Excluded, r.Deleted, r.Skipped, r.Failed)
+		reportMutex.Unlock()
+
+		if err := writeReport(); err != nil {
+			log.Printf("[ERROR] %s", err)
+		}
+
+		return err
+	}
+
+	sweepers[name] = s
+}
+
+// callingSweeperReport returns the report of the running sweeper whose function is in the caller's stack, if any.
+// Sweepers run concurrently, so the calling sweeper is identified from the stack.
+// It must be called from the sweeper's goroutine.
+func callingSweeperReport() *SweeperReport {
+	pc := make([]uintptr, 64)
+	frames := runtime.CallersFrames(pc[:runtime.Callers(2, pc)])
+
+	for {
+		frame, more := frames.Next()
+
+		if name, ok := sweeperFuncs[frame.Entry]; ok {
+			reportMutex.Lock()
+			defer reportMutex.Unlock()
+
+			return runningSweepers[name]
+		}
+
+		if !more {
+			return nil
+		}
+	}
+}
+
+// record updates the report, if any.
+func (r *SweeperReport) record(f func(*SweeperReport)) {
+	if r == nil {
+		return
+	}
+
+	reportMutex.Lock()
+	defer reportMutex.Unlock()
+
+	f(r)
+}
+
+func writeReport() error {
+	path := os.Getenv(conns.EnvVarSweepReport)
+
+	if path == "" {
+		return nil
+	}
+
+	reportMutex.Lock()
+	report.DryRun = dryRun()
+	report.NamePrefix = os.Getenv(conns.EnvVarSweepNamePrefix)
+	report.Tags = selectorTags()
+	b, err := json.MarshalIndent(report, "", "  ")
+	reportMutex.Unlock()
+
+	if err != nil {
+		return fmt.Errorf("error writing sweeper report (%s): %w", path, err)
+	}
+
+	if err := os.WriteFile(path, b, 0644); err != nil {
+		return fmt.Errorf("error writing sweeper report (%s): %w", path, err)
+	}
+
+	return nil
+}
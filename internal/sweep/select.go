@@ -0,0 +1,211 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// ErrCodeSweepMutationBlocked is the error code of requests blocked by dry-run mode or resource selection.
+const ErrCodeSweepMutationBlocked = "SweepMutationBlocked"
+
+// readOnlyOperationPrefixes are the prefixes of API operations allowed in dry-run mode or with resource selection.
+var readOnlyOperationPrefixes = []string{
+	"BatchGet",
+	"Describe",
+	"Get",
+	"Head",
+	"List",
+	"Lookup",
+	"Query",
+	"Scan",
+	"Search",
+	"Select",
+}
+
+// allowedMutations counts the selected resources being deleted by SweepOrchestratorWithContext.
+var allowedMutations int32
+
+// dryRun returns whether sweepers should only report what they would delete.
+func dryRun() bool {
+	v, _ := strconv.ParseBool(os.Getenv(conns.EnvVarSweepDryRun))
+
+	return v
+}
+
+// selectorTags returns the tags that resources must have to be swept,
+// from a comma-separated list of key=value pairs.
+func selectorTags() map[string]string {
+	v := os.Getenv(conns.EnvVarSweepTags)
+
+	if v == "" {
+		return nil
+	}
+
+	tags := make(map[string]string)
+
+	for _, pair := range strings.Split(v, ",") {
+		key, value := pair, ""
+
+		if i := strings.Index(pair, "="); i >= 0 {
+			key, value = pair[:i], pair[i+1:]
+		}
+
+		tags[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+
+	return tags
+}
+
+// restricted returns whether sweepers are limited to reporting or to selected resources.
+func restricted() bool {
+	return dryRun() || os.Getenv(conns.EnvVarSweepNamePrefix) != "" || os.Getenv(conns.EnvVarSweepTags) != ""
+}
+
+// selected returns whether a resource matches the name prefix and tags selectors, if any.
+// The resource is read to populate its name and tags, which sweepers generally do not set.
+func selected(ctx context.Context, sweepResource *SweepResource) (bool, error) {
+	namePrefix := os.Getenv(conns.EnvVarSweepNamePrefix)
+	tags := selectorTags()
+
+	if namePrefix == "" && len(tags) == 0 {
+		return true, nil
+	}
+
+	// Avoid reading the resource when its ID is enough.
+	if namePrefix != "" && len(tags) == 0 && strings.HasPrefix(sweepResource.d.Id(), namePrefix) {
+		return true, nil
+	}
+
+	if err := readResource(ctx, sweepResource.resource, sweepResource.d, sweepResource.meta); err != nil {
+		return false, err
+	}
+
+	d := sweepResource.d
+
+	if d.Id() == "" {
+		return false, nil
+	}
+
+	var resourceTags map[string]interface{}
+
+	for _, k := range []string{"tags_all", "tags"} {
+		if _, ok := sweepResource.resource.Schema[k]; ok {
+			if v, ok := d.Get(k).(map[string]interface{}); ok && len(v) > 0 {
+				resourceTags = v
+				break
+			}
+		}
+	}
+
+	for key, value := range tags {
+		if v, ok := resourceTags[key]; !ok || v.(string) != value {
+			return false, nil
+		}
+	}
+
+	if namePrefix == "" {
+		return true, nil
+	}
+
+	names := []string{d.Id()}
+
+	if _, ok := sweepResource.resource.Schema["name"]; ok {
+		if v, ok := d.Get("name").(string); ok {
+			names = append(names, v)
+		}
+	}
+
+	if v, ok := resourceTags["Name"].(string); ok {
+		names = append(names, v)
+	}
+
+	for _, name := range names {
+		if strings.HasPrefix(name, namePrefix) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func readResource(ctx context.Context, resource *schema.Resource, d *schema.ResourceData, meta interface{}) error {
+	var diags diag.Diagnostics
+
+	switch {
+	case resource.ReadContext != nil:
+		diags = resource.ReadContext(ctx, d, meta)
+	case resource.ReadWithoutTimeout != nil:
+		diags = resource.ReadWithoutTimeout(ctx, d, meta)
+	case resource.Read != nil:
+		return resource.Read(d, meta)
+	}
+
+	for i := range diags {
+		if diags[i].Severity == diag.Error {
+			return fmt.Errorf("error reading resource: %s", diags[i].Summary)
+		}
+	}
+
+	return nil
+}
+
+// blockMutations adds a handler to each AWS SDK for Go v1 client that fails mutating requests
+// unless they are made by SweepOrchestratorWithContext deleting a selected resource.
+// Sweepers that delete resources directly therefore cannot delete resources in dry-run mode
+// or resources that were not selected.
+func blockMutations(awsClient *conns.AWSClient) {
+	v := reflect.ValueOf(awsClient).Elem()
+
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Field(i)
+
+		if !field.CanInterface() || field.Kind() != reflect.Ptr || field.IsNil() || field.Elem().Kind() != reflect.Struct {
+			continue
+		}
+
+		embedded := field.Elem().FieldByName("Client")
+
+		if !embedded.IsValid() || !embedded.CanInterface() {
+			continue
+		}
+
+		c, ok := embedded.Interface().(*client.Client)
+
+		if !ok || c == nil {
+			continue
+		}
+
+		c.Handlers.Validate.PushFrontNamed(request.NamedHandler{Name: "tf.SweepBlockMutationsHandler", Fn: blockMutation})
+	}
+}
+
+func blockMutation(r *request.Request) {
+	for _, prefix := range readOnlyOperationPrefixes {
+		if strings.HasPrefix(r.Operation.Name, prefix) {
+			return
+		}
+	}
+
+	if !dryRun() && atomic.LoadInt32(&allowedMutations) > 0 {
+		return
+	}
+
+	log.Printf("[WARN] Blocking %s %s request made outside the sweeper orchestrator", r.ClientInfo.ServiceID, r.Operation.Name)
+	r.Error = awserr.New(ErrCodeSweepMutationBlocked, fmt.Sprintf("%s %s request blocked: sweeper does not support dry-run mode or resource selection", r.ClientInfo.ServiceID, r.Operation.Name), nil)
+}
@@ -10,6 +10,8 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/endpoints"
@@ -34,6 +36,9 @@ const defaultSweeperAssumeRoleDurationSeconds = 3600
 // This prevents client re-initialization for every resource with no benefit.
 var SweeperClients map[string]interface{}
 
+// sweeperClientsMutex serializes client initialization, as sweepers run concurrently.
+var sweeperClientsMutex sync.Mutex
+
 // SharedRegionalSweepClient returns a common conns.AWSClient setup needed for the sweeper
 // functions for a given region
 func SharedRegionalSweepClient(region string) (interface{}, error) {
@@ -41,6 +46,9 @@ func SharedRegionalSweepClient(region string) (interface{}, error) {
 }
 
 func SharedRegionalSweepClientWithContext(ctx context.Context, region string) (interface{}, error) {
+	sweeperClientsMutex.Lock()
+	defer sweeperClientsMutex.Unlock()
+
 	if client, ok := SweeperClients[region]; ok {
 		return client, nil
 	}
@@ -89,6 +97,10 @@ func SharedRegionalSweepClientWithContext(ctx context.Context, region string) (i
 		return nil, fmt.Errorf("error getting AWS client: %#v", diags)
 	}
 
+	if restricted() {
+		blockMutations(client.(*conns.AWSClient))
+	}
+
 	SweeperClients[region] = client
 
 	return client, nil
@@ -112,42 +124,138 @@ func SweepOrchestrator(sweepResources []*SweepResource) error {
 	return SweepOrchestratorWithContext(context.Background(), sweepResources, 0*time.Millisecond, 0*time.Millisecond, 0*time.Millisecond, 0*time.Millisecond, SweepThrottlingRetryTimeout)
 }
 
+// SweepOrchestratorWithContext deletes the selected resources concurrently.
+// Resources failing with DependencyViolation errors are deleted again once the other resources are deleted,
+// for as long as some resources are deleted.
 func SweepOrchestratorWithContext(ctx context.Context, sweepResources []*SweepResource, delay time.Duration, delayRand time.Duration, minTimeout time.Duration, pollInterval time.Duration, timeout time.Duration) error {
-	var g multierror.Group
-
-	for _, sweepResource := range sweepResources {
-		sweepResource := sweepResource
+	report := callingSweeperReport()
+
+	var errs *multierror.Error
+	pending := sweepResources
+
+	for attempt := 1; len(pending) > 0; attempt++ {
+		var g multierror.Group
+		var mutex sync.Mutex
+		var deleted int
+		violations := make(map[*SweepResource]error)
+
+		for _, sweepResource := range pending {
+			sweepResource := sweepResource
+			attempt := attempt
+
+			g.Go(func() error {
+				if attempt == 1 {
+					report.record(func(r *SweeperReport) {
+						if r.Attempts == 1 {
+							r.Found++
+						}
+					})
+
+					ok, err := selected(ctx, sweepResource)
+
+					if err != nil {
+						report.record(func(r *SweeperReport) { r.Failed++ })
+						return fmt.Errorf("error selecting resource (%s): %w", sweepResource.d.Id(), err)
+					}
 
-		g.Go(func() error {
-			err := tfresource.RetryConfigContext(ctx, delay, delayRand, minTimeout, pollInterval, timeout, func() *resource.RetryError {
-				err := DeleteResource(sweepResource.resource, sweepResource.d, sweepResource.meta)
+					if !ok {
+						log.Printf("[DEBUG] Excluding resource (%s) from sweep", sweepResource.d.Id())
+						report.record(func(r *SweeperReport) {
+							if r.Attempts == 1 {
+								r.Excluded++
+							}
+						})
+						return nil
+					}
 
-				if err != nil {
-					if strings.Contains(err.Error(), "Throttling") {
-						log.Printf("[INFO] While sweeping resource (%s), encountered throttling error (%s). Retrying...", sweepResource.d.Id(), err)
-						return resource.RetryableError(err)
+					if dryRun() {
+						log.Printf("[INFO] Dry run: would delete resource (%s)", sweepResource.d.Id())
+						report.record(func(r *SweeperReport) { r.Deleted++ })
+						return nil
 					}
+				}
 
-					return resource.NonRetryableError(err)
+				err := deleteSweepResource(ctx, sweepResource, delay, delayRand, minTimeout, pollInterval, timeout)
+
+				switch {
+				case skipSweepError(err):
+					log.Printf("[WARN] Skipping resource (%s) sweep: %s", sweepResource.d.Id(), err)
+					report.record(func(r *SweeperReport) { r.Skipped++ })
+				case isDependencyViolation(err):
+					mutex.Lock()
+					violations[sweepResource] = err
+					mutex.Unlock()
+				case err != nil:
+					report.record(func(r *SweeperReport) { r.Failed++ })
+					return err
+				default:
+					mutex.Lock()
+					deleted++
+					mutex.Unlock()
+					report.record(func(r *SweeperReport) { r.Deleted++ })
 				}
 
 				return nil
 			})
+		}
+
+		errs = multierror.Append(errs, g.Wait())
 
-			if tfresource.TimedOut(err) {
-				err = DeleteResource(sweepResource.resource, sweepResource.d, sweepResource.meta)
+		pending = nil
+
+		for sweepResource, err := range violations {
+			if deleted == 0 {
+				report.record(func(r *SweeperReport) { r.Failed++ })
+				errs = multierror.Append(errs, err)
+				continue
 			}
 
-			return err
-		})
+			log.Printf("[INFO] While sweeping resource (%s), encountered dependency violation (%s). Retrying after deleting other resources...", sweepResource.d.Id(), err)
+			pending = append(pending, sweepResource)
+		}
 	}
 
-	return g.Wait().ErrorOrNil()
+	return errs.ErrorOrNil()
+}
+
+func deleteSweepResource(ctx context.Context, sweepResource *SweepResource, delay time.Duration, delayRand time.Duration, minTimeout time.Duration, pollInterval time.Duration, timeout time.Duration) error {
+	atomic.AddInt32(&allowedMutations, 1)
+	defer atomic.AddInt32(&allowedMutations, -1)
+
+	err := tfresource.RetryConfigContext(ctx, delay, delayRand, minTimeout, pollInterval, timeout, func() *resource.RetryError {
+		err := DeleteResource(sweepResource.resource, sweepResource.d, sweepResource.meta)
+
+		if err != nil {
+			if strings.Contains(err.Error(), "Throttling") {
+				log.Printf("[INFO] While sweeping resource (%s), encountered throttling error (%s). Retrying...", sweepResource.d.Id(), err)
+				return resource.RetryableError(err)
+			}
+
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		err = DeleteResource(sweepResource.resource, sweepResource.d, sweepResource.meta)
+	}
+
+	return err
 }
 
 // Check sweeper API call error for reasons to skip sweeping
 // These include missing API endpoints and unsupported API calls
 func SkipSweepError(err error) bool {
+	if skipSweepError(err) {
+		callingSweeperReport().record(func(r *SweeperReport) { r.Skipped++ })
+		return true
+	}
+
+	return false
+}
+
+func skipSweepError(err error) bool {
 	// Ignore missing API endpoints
 	if tfawserr.ErrMessageContains(err, "RequestError", "send request failed") {
 		return true
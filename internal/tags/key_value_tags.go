@@ -3,6 +3,7 @@ package tags
 import (
 	"fmt"
 	"net/url"
+	"path"
 	"reflect"
 	"regexp"
 	"sort"
@@ -30,6 +31,32 @@ type IgnoreConfig struct {
 	KeyPrefixes KeyValueTags
 }
 
+// RequiredConfig contains tags required on all resources.
+type RequiredConfig struct {
+	Tags []*RequiredTag
+
+	// ExemptResourceTypes are resource type patterns, e.g. "aws_iam_*", not required to have any of the tags.
+	ExemptResourceTypes []string
+}
+
+// RequiredTag is a tag required on all resources.
+type RequiredTag struct {
+	Key string
+
+	// AllowedValues are patterns, one of which the tag value must match. If empty, any value is allowed.
+	// Use NewAllowedValue so that a pattern must match the whole value.
+	AllowedValues []*regexp.Regexp
+
+	// ExemptResourceTypes are resource type patterns, e.g. "aws_iam_*", not required to have the tag.
+	ExemptResourceTypes []string
+}
+
+// NewAllowedValue compiles a required tag allowed value pattern anchored to match the whole tag value,
+// e.g. "prod" matches "prod" but not "nonprod" or "production".
+func NewAllowedValue(pattern string) (*regexp.Regexp, error) {
+	return regexp.Compile(`^(?:` + pattern + `)$`)
+}
+
 // KeyValueTags is a standard implementation for AWS key-value resource tags.
 // The AWS Go SDK is split into multiple service packages, each service with
 // its own Go struct type representing a resource tag. To standardize logic
@@ -88,6 +115,78 @@ func (dc *DefaultConfig) TagsEqual(tags KeyValueTags) bool {
 	return dc.Tags.ContainsAll(tags)
 }
 
+// Validate returns an error listing the required tags missing from tags of a resource type,
+// and the tags whose values are not allowed.
+// Tags with a nil value, e.g. a value not known until apply, are present but their value is not checked.
+func (rc *RequiredConfig) Validate(resourceType string, tags KeyValueTags) error {
+	if rc == nil || len(rc.Tags) == 0 || resourceTypeMatches(resourceType, rc.ExemptResourceTypes) {
+		return nil
+	}
+
+	var missing, invalid []string
+
+	for _, requiredTag := range rc.Tags {
+		if resourceTypeMatches(resourceType, requiredTag.ExemptResourceTypes) {
+			continue
+		}
+
+		tagData, ok := tags[requiredTag.Key]
+
+		if !ok {
+			missing = append(missing, requiredTag.Key)
+			continue
+		}
+
+		if tagData == nil || tagData.Value == nil || len(requiredTag.AllowedValues) == 0 {
+			continue
+		}
+
+		var allowed bool
+		patterns := make([]string, len(requiredTag.AllowedValues))
+
+		for i, re := range requiredTag.AllowedValues {
+			patterns[i] = re.String()
+
+			if re.MatchString(*tagData.Value) {
+				allowed = true
+			}
+		}
+
+		if !allowed {
+			invalid = append(invalid, fmt.Sprintf("%q (value %q does not match any of %q)", requiredTag.Key, *tagData.Value, patterns))
+		}
+	}
+
+	if len(missing) == 0 && len(invalid) == 0 {
+		return nil
+	}
+
+	var problems []string
+
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		problems = append(problems, fmt.Sprintf("missing required tags %q", missing))
+	}
+
+	if len(invalid) > 0 {
+		sort.Strings(invalid)
+		problems = append(problems, fmt.Sprintf("invalid values for required tags %s", strings.Join(invalid, ", ")))
+	}
+
+	return fmt.Errorf("%s: %s; set the tags in the resource's \"tags\" argument or the provider's \"default_tags\" configuration block, as required by the provider's \"required_tags\" configuration block", resourceType, strings.Join(problems, "; "))
+}
+
+// resourceTypeMatches returns whether a resource type matches any of the patterns.
+func resourceTypeMatches(resourceType string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, resourceType); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
 // IgnoreConfig returns any tags not removed by a given configuration.
 func (tags KeyValueTags) IgnoreConfig(config *IgnoreConfig) KeyValueTags {
 	if config == nil {
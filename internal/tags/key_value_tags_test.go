@@ -1,6 +1,7 @@
 package tags
 
 import (
+	"regexp"
 	"testing"
 )
 
@@ -2720,3 +2721,143 @@ func testBoolPtr(b bool) *bool {
 func testStringPtr(str string) *string {
 	return &str
 }
+
+func TestRequiredConfigValidate(t *testing.T) {
+	requiredConfig := &RequiredConfig{
+		Tags: []*RequiredTag{
+			{
+				Key:           "cost-center",
+				AllowedValues: []*regexp.Regexp{regexp.MustCompile(`^CC-[0-9]{4}$`)},
+			},
+			{
+				Key:                 "owner",
+				ExemptResourceTypes: []string{"aws_ssm_*"},
+			},
+		},
+		ExemptResourceTypes: []string{"aws_iam_role"},
+	}
+
+	environment, err := NewAllowedValue(`prod|staging`)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	anchoredConfig := &RequiredConfig{
+		Tags: []*RequiredTag{
+			{
+				Key:           "environment",
+				AllowedValues: []*regexp.Regexp{environment},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name           string
+		requiredConfig *RequiredConfig
+		resourceType   string
+		tags           KeyValueTags
+		wantErr        bool
+	}{
+		{
+			name:         "nil config",
+			resourceType: "aws_vpc",
+			tags:         New(map[string]string{}),
+		},
+		{
+			name:           "all tags",
+			requiredConfig: requiredConfig,
+			resourceType:   "aws_vpc",
+			tags: New(map[string]string{
+				"cost-center": "CC-1234",
+				"owner":       "team",
+			}),
+		},
+		{
+			name:           "missing tag",
+			requiredConfig: requiredConfig,
+			resourceType:   "aws_vpc",
+			tags: New(map[string]string{
+				"cost-center": "CC-1234",
+			}),
+			wantErr: true,
+		},
+		{
+			name:           "invalid value",
+			requiredConfig: requiredConfig,
+			resourceType:   "aws_vpc",
+			tags: New(map[string]string{
+				"cost-center": "1234",
+				"owner":       "team",
+			}),
+			wantErr: true,
+		},
+		{
+			name:           "unknown value",
+			requiredConfig: requiredConfig,
+			resourceType:   "aws_vpc",
+			tags: KeyValueTags{
+				"cost-center": &TagData{},
+				"owner":       &TagData{},
+			},
+		},
+		{
+			name:           "tag exempt resource type",
+			requiredConfig: requiredConfig,
+			resourceType:   "aws_ssm_parameter",
+			tags: New(map[string]string{
+				"cost-center": "CC-1234",
+			}),
+		},
+		{
+			name:           "exempt resource type",
+			requiredConfig: requiredConfig,
+			resourceType:   "aws_iam_role",
+			tags:           New(map[string]string{}),
+		},
+		{
+			name:           "anchored value",
+			requiredConfig: anchoredConfig,
+			resourceType:   "aws_vpc",
+			tags: New(map[string]string{
+				"environment": "prod",
+			}),
+		},
+		{
+			name:           "anchored value alternative",
+			requiredConfig: anchoredConfig,
+			resourceType:   "aws_vpc",
+			tags: New(map[string]string{
+				"environment": "staging",
+			}),
+		},
+		{
+			name:           "anchored value prefix",
+			requiredConfig: anchoredConfig,
+			resourceType:   "aws_vpc",
+			tags: New(map[string]string{
+				"environment": "nonprod",
+			}),
+			wantErr: true,
+		},
+		{
+			name:           "anchored value suffix",
+			requiredConfig: anchoredConfig,
+			resourceType:   "aws_vpc",
+			tags: New(map[string]string{
+				"environment": "production-old",
+			}),
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := testCase.requiredConfig.Validate(testCase.resourceType, testCase.tags)
+
+			if gotErr := err != nil; gotErr != testCase.wantErr {
+				t.Errorf("got error %v, expected error %t", err, testCase.wantErr)
+			}
+		})
+	}
+}
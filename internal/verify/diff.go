@@ -18,9 +18,12 @@ import (
 // to those configured at the provider-level to avoid non-empty plans
 // after resource READ operations as resource and provider-level tags
 // will be indistinguishable when returned from an AWS API.
-func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+// The merged tags must also include any tags required by the provider-level
+// required_tags configuration for the resource type.
+func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	requiredTagsConfig := meta.(*conns.AWSClient).RequiredTagsConfig
 
 	resourceTags := tftags.New(diff.Get("tags").(map[string]interface{}))
 
@@ -28,6 +31,10 @@ func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{})
 		return fmt.Errorf(`"tags" are identical to those in the "default_tags" configuration block of the provider: please de-duplicate and try again`)
 	}
 
+	if err := validateRequiredTags(ctx, diff, requiredTagsConfig, defaultTagsConfig.MergeTags(resourceTags)); err != nil {
+		return err
+	}
+
 	allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
 
 	// To ensure "tags_all" is correctly computed, we explicitly set the attribute diff
@@ -53,6 +60,32 @@ func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{})
 	return nil
 }
 
+// validateRequiredTags returns an error if tags do not satisfy the provider-level required_tags configuration.
+// Requirements are only checked once the tags are known, and tag values not known until apply are not checked.
+func validateRequiredTags(ctx context.Context, diff *schema.ResourceDiff, config *tftags.RequiredConfig, tags tftags.KeyValueTags) error {
+	if config == nil || !diff.NewValueKnown("tags") {
+		return nil
+	}
+
+	resourceType, ok := conns.ResourceTypeFromContext(ctx)
+
+	if !ok {
+		return nil
+	}
+
+	knownTags := make(tftags.KeyValueTags, len(tags))
+
+	for k, v := range tags {
+		if diff.NewValueKnown("tags." + k) {
+			knownTags[k] = v
+		} else {
+			knownTags[k] = &tftags.TagData{}
+		}
+	}
+
+	return config.Validate(resourceType, knownTags)
+}
+
 // SuppressEquivalentTypeStringBoolean provides custom difference suppression for TypeString booleans
 // Some arguments require three values: true, false, and "" (unspecified), but
 // confusing behavior exists when converting bare true/false values with state.